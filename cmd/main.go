@@ -3,9 +3,12 @@ package main
 import (
 	"ApiRestFinance/internal/config"
 	"ApiRestFinance/internal/controller"
+	"ApiRestFinance/internal/mailer"
 	"ApiRestFinance/internal/middleware"
 	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
 	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/scheduler"
 	"ApiRestFinance/internal/service"
 	"fmt"
 	swaggerFiles "github.com/swaggo/files"
@@ -58,18 +61,80 @@ func main() {
 	lateFeeRepo := repository.NewLateFeeRepository(db)
 	lateFeeRuleRepo := repository.NewLateFeeRuleRepository(db)
 	installmentRepo := repository.NewInstallmentRepository(db)
+	statementRepo := repository.NewStatementRepository(db)
+	scheduledJobRepo := repository.NewScheduledJobRepository(db)
+	idempotencyKeyRepo := repository.NewIdempotencyKeyRepository(db)
+	ledgerRepo := repository.NewLedgerRepository(db)
+	reconciliationRepo := repository.NewReconciliationRepository(db)
+	roleRepo := repository.NewRoleRepository(db)
+	tenantRepo := repository.NewTenantRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	passwordResetTokenRepo := repository.NewPasswordResetTokenRepository(db)
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	creditPolicyRepo := repository.NewCreditPolicyRepository(db)
+	creditLimitChangeRepo := repository.NewCreditLimitChangeRepository(db)
+	delinquencyPolicyRepo := repository.NewDelinquencyPolicyRepository(db)
+
+	// Initialize the mailer
+	var mail mailer.Mailer
+	switch cfg.MailDriver {
+	case "smtp":
+		mail = mailer.NewSMTPMailer(cfg.SMTP)
+	case "log":
+		mail = mailer.NewLogMailer(cfg.MailLogFile)
+	default:
+		log.Fatalf("Unknown MAIL_DRIVER %q, expected smtp or log", cfg.MailDriver)
+	}
+
+	// Seed the roles and bootstrap the platform admin
+	if err := roleRepo.EnsureRoles(); err != nil {
+		log.Fatal("Error seeding roles: ", err)
+	}
+	if cfg.PlatformAdminEmail != "" {
+		if err := grantPlatformAdmin(userRepo, roleRepo, cfg.PlatformAdminEmail); err != nil {
+			log.Println("Error granting platform admin role: ", err)
+		}
+	}
 
 	// Initialize services
-	authService := service.NewAuthService(userRepo, establishmentRepo, cfg.JwtSecret)
-	clientService := service.NewClientService(clientRepo, userRepo)
+	authService := service.NewAuthService(userRepo, establishmentRepo, roleRepo, refreshTokenRepo, cfg.JwtSecret)
+	clientService := service.NewClientService(clientRepo, userRepo, roleRepo)
 	establishmentService := service.NewEstablishmentService(establishmentRepo)
-	adminService := service.NewAdminService(adminRepo, establishmentRepo, userRepo)
+	adminService := service.NewAdminService(adminRepo, establishmentRepo, userRepo, roleRepo)
 	productService := service.NewProductService(productRepo)
-	creditAccountService := service.NewCreditAccountService(creditAccountRepo, transactionRepo, clientRepo, establishmentRepo, installmentRepo)
+	creditAccountService := service.NewCreditAccountService(creditAccountRepo, transactionRepo, clientRepo, establishmentRepo, installmentRepo, creditPolicyRepo)
 	transactionService := service.NewTransactionService(transactionRepo, creditAccountRepo)
 	lateFeeService := service.NewLateFeeService(lateFeeRepo)
 	lateFeeRuleService := service.NewLateFeeRuleService(lateFeeRuleRepo)
 	installmentService := service.NewInstallmentService(installmentRepo)
+	statementService := service.NewStatementService(statementRepo, creditAccountRepo)
+	ledgerService := service.NewLedgerService(ledgerRepo)
+	reconciliationService := service.NewReconciliationService(reconciliationRepo)
+	roleService := service.NewRoleService(roleRepo)
+	passwordResetService := service.NewPasswordResetService(userRepo, passwordResetTokenRepo, refreshTokenRepo, mail, cfg.PasswordResetURL)
+	auditService := service.NewAuditService(auditLogRepo)
+	creditPolicyService := service.NewCreditPolicyService(creditPolicyRepo)
+	creditLimitService := service.NewCreditLimitService(creditLimitChangeRepo, creditAccountRepo, creditPolicyRepo)
+	delinquencyService := service.NewDelinquencyService(delinquencyPolicyRepo, creditAccountRepo)
+
+	// Initialize the job scheduler
+	jobScheduler := scheduler.New(db, scheduledJobRepo, establishmentRepo)
+	if err := jobScheduler.Register(scheduler.ApplyInterestJob, "Accrues interest on every credit account of the establishment", cfg.InterestJobCron, creditAccountService.ApplyInterestToAllAccounts); err != nil {
+		log.Fatal("Error registering scheduled job: ", err)
+	}
+	if err := jobScheduler.Register(scheduler.ApplyLateFeesJob, "Applies late fees to the overdue credit accounts of the establishment", cfg.LateFeeJobCron, creditAccountService.ApplyLateFeesToAllAccounts); err != nil {
+		log.Fatal("Error registering scheduled job: ", err)
+	}
+	if err := jobScheduler.Register(scheduler.ReviewLimitsJob, "Proposes credit limit changes from the credit scores of the establishment's clients", cfg.LimitReviewJobCron, creditLimitService.ReviewCreditLimits); err != nil {
+		log.Fatal("Error registering scheduled job: ", err)
+	}
+	if err := jobScheduler.Register(scheduler.DelinquencyJob, "Blocks and unblocks the credit accounts of the establishment following its delinquency policy", cfg.DelinquencyJobCron, delinquencyService.ApplyDelinquencyPolicy); err != nil {
+		log.Fatal("Error registering scheduled job: ", err)
+	}
+	if cfg.SchedulerEnabled {
+		jobScheduler.Start()
+		defer jobScheduler.Stop()
+	}
 
 	// Initialize controllers
 	authController := controller.NewAuthController(authService)
@@ -82,6 +147,16 @@ func main() {
 	lateFeeController := controller.NewLateFeeController(lateFeeService)
 	lateFeeRuleController := controller.NewLateFeeRuleController(lateFeeRuleService)
 	installmentController := controller.NewInstallmentController(installmentService)
+	statementController := controller.NewStatementController(statementService)
+	ledgerController := controller.NewLedgerController(ledgerService)
+	reconciliationController := controller.NewReconciliationController(reconciliationService)
+	jobController := controller.NewJobController(jobScheduler)
+	roleController := controller.NewRoleController(roleService)
+	passwordResetController := controller.NewPasswordResetController(passwordResetService)
+	auditController := controller.NewAuditController(auditService)
+	creditPolicyController := controller.NewCreditPolicyController(creditPolicyService)
+	creditLimitController := controller.NewCreditLimitController(creditLimitService)
+	delinquencyController := controller.NewDelinquencyController(delinquencyService)
 
 	// Initialize Gin router
 	router := gin.Default()
@@ -90,105 +165,49 @@ func main() {
 
 	// CORS middleware
 	router.Use(middleware.CorsMiddleware())
+	// Request ID middleware, correlates logs and audit entries
+	router.Use(middleware.RequestIDMiddleware())
 
 	url := ginSwagger.URL("http://localhost:8080/swagger/doc.json")
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
 
+	// State-changing requests are recorded in the audit log
+	auditTrail := middleware.AuditMiddleware(auditLogRepo)
+
 	// Public routes
 	publicRoutes := router.Group("/api/v1")
 	{
-		publicRoutes.POST("/register", authController.Register)
+		publicRoutes.POST("/register", auditTrail, authController.Register)
 		publicRoutes.POST("/login", authController.Login)
 		publicRoutes.POST("/refresh", authController.RefreshToken)
+		publicRoutes.POST("/logout", authController.Logout)
+		publicRoutes.POST("/forgot-password", passwordResetController.RequestPasswordReset)
+		publicRoutes.POST("/forgot-password/confirm", auditTrail, passwordResetController.ConfirmPasswordReset)
 	}
 
 	// Protected routes (require authentication)
-	protectedRoutes := router.Group("/api/v1", middleware.AuthMiddleware(cfg.JwtSecret))
-	{
-		// Establishment routes
-		protectedRoutes.POST("/establishments", adminController.RegisterEstablishment)
-		protectedRoutes.PUT("/establishments/:id", establishmentController.UpdateEstablishment)
-		protectedRoutes.DELETE("/establishments/:id", establishmentController.DeleteEstablishment)
-		// Use a different parameter name for the client route
-		protectedRoutes.PUT("/establishments/:id/clients/:client_id", establishmentController.AddClientToEstablishment)
-
-		// Client routes
-		protectedRoutes.POST("/clients", clientController.CreateClient)
-		protectedRoutes.GET("/clients", clientController.GetAllClients)
-		protectedRoutes.GET("/clients/:id", clientController.GetClientByID)
-		protectedRoutes.PUT("/clients/:id", clientController.UpdateClient)
-		protectedRoutes.DELETE("/clients/:id", clientController.DeleteClient)
-
-		// Admin routes
-		protectedRoutes.POST("/admins", adminController.CreateAdmin)
-		protectedRoutes.GET("/admins", adminController.GetAllAdmins)
-		protectedRoutes.GET("/admins/:id", adminController.GetAdminByID)
-		protectedRoutes.PUT("/admins/:id", adminController.UpdateAdmin)
-		protectedRoutes.DELETE("/admins/:id", adminController.DeleteAdmin)
-
-		// Authentication route (reset password)
-		protectedRoutes.POST("/reset-password", authController.ResetPassword)
-
-		// Product routes
-		protectedRoutes.POST("/products", productController.CreateProduct)
-		protectedRoutes.GET("/products", productController.GetAllProducts)
-		protectedRoutes.GET("/products/:id", productController.GetProductByID)
-		protectedRoutes.GET("/establishments/:establishment_id/products", productController.GetProductsByEstablishmentID)
-		protectedRoutes.PUT("/products/:id", productController.UpdateProduct)
-		protectedRoutes.DELETE("/products/:id", productController.DeleteProduct)
-
-		// Credit Account Routes
-		protectedRoutes.POST("/credit-accounts", creditAccountController.CreateCreditAccount)
-		protectedRoutes.GET("/credit-accounts/:id", creditAccountController.GetCreditAccountByID)
-		protectedRoutes.PUT("/credit-accounts/:id", creditAccountController.UpdateCreditAccount)
-		protectedRoutes.DELETE("/credit-accounts/:id", creditAccountController.DeleteCreditAccount)
-		protectedRoutes.GET("/establishments/:establishment_id/credit-accounts", creditAccountController.GetCreditAccountsByEstablishmentID)
-		protectedRoutes.GET("/clients/:id/credit-accounts", creditAccountController.GetCreditAccountsByClientID)
-		protectedRoutes.POST("/establishments/:establishment_id/credit-accounts/apply-interest", creditAccountController.ApplyInterestToAllAccounts)
-		protectedRoutes.POST("/establishments/:establishment_id/credit-accounts/apply-late-fees", creditAccountController.ApplyLateFeesToAllAccounts)
-		protectedRoutes.GET("/establishments/:establishment_id/credit-accounts/debt-summary", creditAccountController.GetAdminDebtSummary)
-		protectedRoutes.POST("/credit-accounts/:id/purchases", creditAccountController.ProcessPurchase)
-		protectedRoutes.POST("/credit-accounts/:id/payments", creditAccountController.ProcessPayment)
-		protectedRoutes.PUT("/credit-accounts/:id/clients/:id", creditAccountController.AssignCreditAccountToClient)
-
-		// Credit Request Routes
-		protectedRoutes.POST("/credit-requests", creditAccountController.CreateCreditRequest)
-		protectedRoutes.GET("/credit-requests/:id", creditAccountController.GetCreditRequestByID)
-		protectedRoutes.PUT("/credit-requests/:id/approve", creditAccountController.ApproveCreditRequest)
-		protectedRoutes.PUT("/credit-requests/:id/reject", creditAccountController.RejectCreditRequest)
-		protectedRoutes.GET("/establishments/:establishment_id/credit-requests/pending", creditAccountController.GetPendingCreditRequests)
-
-		// Transaction Routes
-		protectedRoutes.POST("/transactions", transactionController.CreateTransaction)
-		protectedRoutes.GET("/transactions/:id", transactionController.GetTransactionByID)
-		protectedRoutes.PUT("/transactions/:id", transactionController.UpdateTransaction)
-		protectedRoutes.DELETE("/transactions/:id", transactionController.DeleteTransaction)
-		protectedRoutes.GET("/credit-accounts/:id/transactions", transactionController.GetTransactionsByCreditAccountID)
-
-		// Late Fee Routes
-		protectedRoutes.POST("/late-fees", lateFeeController.CreateLateFee)
-		protectedRoutes.GET("/late-fees/:id", lateFeeController.GetLateFeeByID)
-		protectedRoutes.PUT("/late-fees/:id", lateFeeController.UpdateLateFee)
-		protectedRoutes.DELETE("/late-fees/:id", lateFeeController.DeleteLateFee)
-		protectedRoutes.GET("/credit-accounts/:id/late-fees", lateFeeController.GetLateFeesByCreditAccountID)
-
-		// Late Fee Rule Routes
-		protectedRoutes.POST("/late-fee-rules", lateFeeRuleController.CreateLateFeeRule)
-		protectedRoutes.GET("/late-fee-rules/:id", lateFeeRuleController.GetLateFeeRuleByID)
-		protectedRoutes.PUT("/late-fee-rules/:id", lateFeeRuleController.UpdateLateFeeRule)
-		protectedRoutes.DELETE("/late-fee-rules/:id", lateFeeRuleController.DeleteLateFeeRule)
-		protectedRoutes.GET("/late-fee-rules", lateFeeRuleController.GetAllLateFeeRules)
-		protectedRoutes.GET("/establishments/:establishment_id/late-fee-rules", lateFeeRuleController.GetLateFeeRulesByEstablishmentID)
-
-		// Installment Routes
-		protectedRoutes.POST("/installments", installmentController.CreateInstallment)
-		protectedRoutes.GET("/installments/:id", installmentController.GetInstallmentByID)
-		protectedRoutes.PUT("/installments/:id", installmentController.UpdateInstallment)
-		protectedRoutes.DELETE("/installments/:id", installmentController.DeleteInstallment)
-		protectedRoutes.GET("/credit-accounts/:id/installments", installmentController.GetInstallmentsByCreditAccountID)
-		protectedRoutes.GET("/credit-accounts/:id/installments/overdue", installmentController.GetOverdueInstallments)
-
-	}
+	protectedRoutes := router.Group("/api/v1", middleware.AuthMiddleware(cfg.JwtSecret), auditTrail)
+	registerProtectedRoutes(protectedRoutes, apiControllers{
+		auth:           authController,
+		establishment:  establishmentController,
+		client:         clientController,
+		admin:          adminController,
+		product:        productController,
+		creditAccount:  creditAccountController,
+		creditLimit:    creditLimitController,
+		creditPolicy:   creditPolicyController,
+		delinquency:    delinquencyController,
+		transaction:    transactionController,
+		lateFee:        lateFeeController,
+		lateFeeRule:    lateFeeRuleController,
+		installment:    installmentController,
+		statement:      statementController,
+		ledger:         ledgerController,
+		reconciliation: reconciliationController,
+		job:            jobController,
+		role:           roleController,
+		audit:          auditController,
+	}, tenantRepo, idempotencyKeyRepo)
 
 	// Start the server
 	fmt.Printf("Starting server on port %s...\n", cfg.ServerPort)
@@ -197,6 +216,15 @@ func main() {
 	}
 }
 
+// grantPlatformAdmin grants the platform admin role to the user with the given email.
+func grantPlatformAdmin(userRepo repository.UserRepository, roleRepo repository.RoleRepository, email string) error {
+	user, err := userRepo.GetUserByEmail(email)
+	if err != nil {
+		return fmt.Errorf("error retrieving user %s: %w", email, err)
+	}
+	return roleRepo.GrantRole(user.ID, enums.PlatformAdminRole)
+}
+
 // Migrate the database tables
 func migrateDB(db *gorm.DB) error {
 	return db.AutoMigrate(
@@ -207,10 +235,26 @@ func migrateDB(db *gorm.DB) error {
 		&entities.Establishment{},
 		&entities.Product{},
 		&entities.CreditAccount{},
+		&entities.CreditRequest{},
+		&entities.CreditPolicy{},
+		&entities.CreditLimitChange{},
+		&entities.DelinquencyPolicy{},
 		&entities.Transaction{},
+		&entities.PurchaseLine{},
 		&entities.LateFee{},
 		&entities.LateFeeRule{},
 		&entities.Installment{},
 		&entities.CreditAccountHistory{},
+		&entities.Statement{},
+		&entities.ScheduledJob{},
+		&entities.JobRun{},
+		&entities.IdempotencyKey{},
+		&entities.PaymentAllocation{},
+		&entities.LedgerAccount{},
+		&entities.JournalEntry{},
+		&entities.JournalLine{},
+		&entities.RefreshToken{},
+		&entities.PasswordResetToken{},
+		&entities.AuditLog{},
 	)
 }
@@ -0,0 +1,55 @@
+// Command reconcile rebuilds the balance of every credit account from its history and reports
+// the accounts whose stored balance, transactions or ledger disagree with it.
+//
+// Usage:
+//
+//	go run ./cmd/reconcile [-establishment ID] [-apply]
+//
+// It runs as a dry run unless -apply is given, in which case stored balances that disagree with
+// the history are corrected with an ADJUSTMENT. It exits with status 1 when a dry run finds
+// discrepancies, so it can be used as a check.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"ApiRestFinance/internal/config"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/service"
+)
+
+func main() {
+	establishmentID := flag.Uint("establishment", 0, "Only reconcile the credit accounts of this establishment (0 for all)")
+	apply := flag.Bool("apply", false, "Post correcting adjustments instead of only reporting")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatal("Error loading configuration: ", err)
+	}
+
+	reconciliationService := service.NewReconciliationService(repository.NewReconciliationRepository(cfg.DB))
+	report, err := reconciliationService.Reconcile(*establishmentID, !*apply)
+	if err != nil {
+		log.Fatal("Error reconciling balances: ", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "ACCOUNT\tESTABLISHMENT\tCURRENT\tHISTORY\tTRANSACTIONS\tLEDGER\tDIFFERENCE\tADJUSTED\t")
+	for _, account := range report.Accounts {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\t%s\t%t\t\n",
+			account.CreditAccountID, account.EstablishmentID, account.CurrentBalance, account.HistoryBalance,
+			account.TransactionBalance, account.LedgerBalance, account.Difference, account.Adjusted)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d accounts checked, %d with discrepancies, %d adjusted (dry run: %t)\n",
+		report.AccountsChecked, report.Discrepancies, report.Adjusted, report.DryRun)
+	if report.DryRun && report.Discrepancies > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"ApiRestFinance/internal/controller"
+	"ApiRestFinance/internal/middleware"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiControllers holds the controllers that serve the protected routes.
+type apiControllers struct {
+	auth           *controller.AuthController
+	establishment  *controller.EstablishmentController
+	client         *controller.ClientController
+	admin          *controller.AdminController
+	product        *controller.ProductController
+	creditAccount  *controller.CreditAccountController
+	creditLimit    *controller.CreditLimitController
+	creditPolicy   *controller.CreditPolicyController
+	delinquency    *controller.DelinquencyController
+	transaction    *controller.TransactionController
+	lateFee        *controller.LateFeeController
+	lateFeeRule    *controller.LateFeeRuleController
+	installment    *controller.InstallmentController
+	statement      *controller.StatementController
+	ledger         *controller.LedgerController
+	reconciliation *controller.ReconciliationController
+	job            *controller.JobController
+	role           *controller.RoleController
+	audit          *controller.AuditController
+}
+
+// registerProtectedRoutes registers the routes that require authentication on a group that
+// already resolves the caller. Role guards run before tenant guards, so a caller gets 403 for
+// actions its role does not allow and 404 for the data of other establishments.
+func registerProtectedRoutes(protectedRoutes *gin.RouterGroup, c apiControllers, tenantRepo repository.TenantRepository, idempotencyKeyRepo repository.IdempotencyKeyRepository) {
+	// Money-moving endpoints require an Idempotency-Key header
+	idempotent := middleware.IdempotencyMiddleware(idempotencyKeyRepo)
+	// Role guards, platform admins pass all of them
+	platformAdmin := middleware.RequireRoles()
+	establishmentAdmin := middleware.RequireRoles(enums.EstablishmentAdminRole)
+	staff := middleware.RequireRoles(enums.EstablishmentAdminRole, enums.CashierRole)
+	staffOrClient := middleware.RequireRoles(enums.EstablishmentAdminRole, enums.CashierRole, enums.ClientRole)
+	// Tenant guards, data of other establishments answers 404
+	ownsEstablishment := middleware.RequireTenant(tenantRepo, repository.EstablishmentResource, "establishment_id")
+	ownsClient := middleware.RequireTenant(tenantRepo, repository.ClientResource, "id")
+	applicant := middleware.RequireTenant(tenantRepo, repository.ApplicantResource, "client_id")
+	ownsAccount := middleware.RequireTenant(tenantRepo, repository.CreditAccountResource, "id")
+	ownsCreditRequest := middleware.RequireTenant(tenantRepo, repository.CreditRequestResource, "id")
+	ownsTransaction := middleware.RequireTenant(tenantRepo, repository.TransactionResource, "id")
+	ownsLateFee := middleware.RequireTenant(tenantRepo, repository.LateFeeResource, "id")
+	ownsLateFeeRule := middleware.RequireTenant(tenantRepo, repository.LateFeeRuleResource, "id")
+	ownsInstallment := middleware.RequireTenant(tenantRepo, repository.InstallmentResource, "id")
+	ownsProduct := middleware.RequireTenant(tenantRepo, repository.ProductResource, "id")
+	ownsLimitChange := middleware.RequireTenant(tenantRepo, repository.CreditLimitChangeResource, "id")
+	bodyEstablishment := middleware.RequireTenantBody(tenantRepo, repository.EstablishmentResource, "establishment_id")
+	bodyClient := middleware.RequireTenantBody(tenantRepo, repository.ClientResource, "client_id")
+	bodyAccount := middleware.RequireTenantBody(tenantRepo, repository.CreditAccountResource, "credit_account_id")
+
+	// Establishment routes
+	protectedRoutes.POST("/establishments", platformAdmin, c.admin.RegisterEstablishment)
+	protectedRoutes.PUT("/establishments/:establishment_id", establishmentAdmin, ownsEstablishment, c.establishment.UpdateEstablishment)
+	protectedRoutes.DELETE("/establishments/:establishment_id", platformAdmin, c.establishment.DeleteEstablishment)
+	protectedRoutes.PUT("/establishments/:establishment_id/clients/:client_id", staff, ownsEstablishment, applicant, c.establishment.AddClientToEstablishment)
+
+	// Client routes
+	protectedRoutes.POST("/clients", staff, c.client.CreateClient)
+	protectedRoutes.GET("/clients", staff, c.client.GetAllClients)
+	protectedRoutes.GET("/clients/:id", staffOrClient, ownsClient, c.client.GetClientByID)
+	protectedRoutes.PUT("/clients/:id", staff, ownsClient, c.client.UpdateClient)
+	protectedRoutes.DELETE("/clients/:id", establishmentAdmin, ownsClient, c.client.DeleteClient)
+
+	// Admin routes
+	protectedRoutes.POST("/admins", platformAdmin, c.admin.CreateAdmin)
+	protectedRoutes.GET("/admins", platformAdmin, c.admin.GetAllAdmins)
+	protectedRoutes.GET("/admins/:id", platformAdmin, c.admin.GetAdminByID)
+	protectedRoutes.PUT("/admins/:id", platformAdmin, c.admin.UpdateAdmin)
+	protectedRoutes.DELETE("/admins/:id", platformAdmin, c.admin.DeleteAdmin)
+
+	// Authentication route (reset password)
+	protectedRoutes.POST("/reset-password", c.auth.ResetPassword)
+	protectedRoutes.POST("/logout-all", c.auth.LogoutAll)
+
+	// Product routes
+	protectedRoutes.POST("/products", establishmentAdmin, bodyEstablishment, c.product.CreateProduct)
+	protectedRoutes.GET("/products", c.product.GetAllProducts)
+	protectedRoutes.GET("/products/:id", ownsProduct, c.product.GetProductByID)
+	protectedRoutes.GET("/establishments/:establishment_id/products", ownsEstablishment, c.product.GetProductsByEstablishmentID)
+	protectedRoutes.PUT("/products/:id", establishmentAdmin, ownsProduct, c.product.UpdateProduct)
+	protectedRoutes.DELETE("/products/:id", establishmentAdmin, ownsProduct, c.product.DeleteProduct)
+
+	// Credit Account Routes
+	protectedRoutes.POST("/credit-accounts", establishmentAdmin, bodyEstablishment, bodyClient, c.creditAccount.CreateCreditAccount)
+	protectedRoutes.GET("/credit-accounts/:id", staffOrClient, ownsAccount, c.creditAccount.GetCreditAccountByID)
+	protectedRoutes.PUT("/credit-accounts/:id", establishmentAdmin, ownsAccount, c.creditAccount.UpdateCreditAccount)
+	protectedRoutes.DELETE("/credit-accounts/:id", establishmentAdmin, ownsAccount, c.creditAccount.DeleteCreditAccount)
+	protectedRoutes.GET("/establishments/:establishment_id/credit-accounts", staff, ownsEstablishment, c.creditAccount.GetCreditAccountsByEstablishmentID)
+	protectedRoutes.GET("/clients/:id/credit-accounts", staffOrClient, ownsClient, c.creditAccount.GetCreditAccountsByClientID)
+	protectedRoutes.POST("/establishments/:establishment_id/credit-accounts/apply-interest", establishmentAdmin, ownsEstablishment, c.creditAccount.ApplyInterestToAllAccounts)
+	protectedRoutes.POST("/establishments/:establishment_id/credit-accounts/apply-late-fees", establishmentAdmin, ownsEstablishment, c.creditAccount.ApplyLateFeesToAllAccounts)
+	protectedRoutes.GET("/establishments/:establishment_id/credit-accounts/debt-summary", establishmentAdmin, ownsEstablishment, c.creditAccount.GetAdminDebtSummary)
+	protectedRoutes.POST("/credit-accounts/:id/purchases", staff, ownsAccount, idempotent, c.creditAccount.ProcessPurchase)
+	protectedRoutes.POST("/credit-accounts/:id/purchases/itemized", staff, ownsAccount, idempotent, c.creditAccount.ProcessItemizedPurchase)
+	protectedRoutes.POST("/credit-accounts/:id/payments", staffOrClient, ownsAccount, idempotent, c.creditAccount.ProcessPayment)
+	protectedRoutes.POST("/credit-accounts/:id/prepayments", staffOrClient, ownsAccount, idempotent, c.creditAccount.Prepay)
+	protectedRoutes.PUT("/credit-accounts/:id/clients/:id", establishmentAdmin, ownsAccount, c.creditAccount.AssignCreditAccountToClient)
+
+	// Credit Request Routes
+	protectedRoutes.POST("/credit-requests", staffOrClient, bodyEstablishment, bodyClient, c.creditAccount.CreateCreditRequest)
+	protectedRoutes.GET("/credit-requests/:id", staffOrClient, ownsCreditRequest, c.creditAccount.GetCreditRequestByID)
+	protectedRoutes.PUT("/credit-requests/:id/approve", establishmentAdmin, ownsCreditRequest, c.creditAccount.ApproveCreditRequest)
+	protectedRoutes.PUT("/credit-requests/:id/reject", establishmentAdmin, ownsCreditRequest, c.creditAccount.RejectCreditRequest)
+	protectedRoutes.GET("/establishments/:establishment_id/credit-requests/pending", staff, ownsEstablishment, c.creditAccount.GetPendingCreditRequests)
+	// Credit Limit Change Routes
+	protectedRoutes.POST("/credit-accounts/:id/limit-changes", staffOrClient, ownsAccount, c.creditLimit.RequestLimitChange)
+	protectedRoutes.GET("/credit-accounts/:id/limit-changes", staffOrClient, ownsAccount, c.creditLimit.GetLimitChanges)
+	protectedRoutes.PUT("/limit-changes/:id/approve", establishmentAdmin, ownsLimitChange, c.creditLimit.ApproveLimitChange)
+	protectedRoutes.PUT("/limit-changes/:id/reject", establishmentAdmin, ownsLimitChange, c.creditLimit.RejectLimitChange)
+	protectedRoutes.POST("/establishments/:establishment_id/credit-accounts/review-limits", establishmentAdmin, ownsEstablishment, c.creditLimit.ReviewCreditLimits)
+
+	protectedRoutes.GET("/establishments/:establishment_id/credit-policy", establishmentAdmin, ownsEstablishment, c.creditPolicy.GetCreditPolicy)
+	protectedRoutes.PUT("/establishments/:establishment_id/credit-policy", establishmentAdmin, ownsEstablishment, c.creditPolicy.UpdateCreditPolicy)
+
+	// Delinquency Policy Routes
+	protectedRoutes.GET("/establishments/:establishment_id/delinquency-policy", establishmentAdmin, ownsEstablishment, c.delinquency.GetDelinquencyPolicy)
+	protectedRoutes.PUT("/establishments/:establishment_id/delinquency-policy", establishmentAdmin, ownsEstablishment, c.delinquency.UpdateDelinquencyPolicy)
+	protectedRoutes.POST("/establishments/:establishment_id/credit-accounts/apply-delinquency-policy", establishmentAdmin, ownsEstablishment, c.delinquency.ApplyDelinquencyPolicy)
+
+	// Transaction Routes
+	protectedRoutes.POST("/transactions", establishmentAdmin, bodyAccount, idempotent, c.transaction.CreateTransaction)
+	protectedRoutes.GET("/transactions/:id", staffOrClient, ownsTransaction, c.transaction.GetTransactionByID)
+	protectedRoutes.GET("/transactions/:id/allocations", staffOrClient, ownsTransaction, c.transaction.GetPaymentAllocations)
+	protectedRoutes.POST("/transactions/:id/reverse", establishmentAdmin, ownsTransaction, idempotent, c.transaction.ReverseTransaction)
+	protectedRoutes.POST("/credit-accounts/:id/adjustments", establishmentAdmin, ownsAccount, idempotent, c.transaction.CreateAdjustment)
+	protectedRoutes.POST("/credit-accounts/:id/write-off", establishmentAdmin, ownsAccount, idempotent, c.transaction.WriteOff)
+	protectedRoutes.GET("/credit-accounts/:id/transactions", staffOrClient, ownsAccount, c.transaction.GetTransactionsByCreditAccountID)
+	protectedRoutes.GET("/credit-accounts/:id/history", staffOrClient, ownsAccount, c.transaction.GetTransactionHistory)
+
+	// Late Fee Routes
+	protectedRoutes.POST("/late-fees", establishmentAdmin, bodyAccount, c.lateFee.CreateLateFee)
+	protectedRoutes.GET("/late-fees/:id", staffOrClient, ownsLateFee, c.lateFee.GetLateFeeByID)
+	protectedRoutes.PUT("/late-fees/:id", establishmentAdmin, ownsLateFee, c.lateFee.UpdateLateFee)
+	protectedRoutes.DELETE("/late-fees/:id", establishmentAdmin, ownsLateFee, c.lateFee.DeleteLateFee)
+	protectedRoutes.GET("/credit-accounts/:id/late-fees", staffOrClient, ownsAccount, c.lateFee.GetLateFeesByCreditAccountID)
+
+	// Late Fee Rule Routes
+	protectedRoutes.POST("/late-fee-rules", establishmentAdmin, bodyEstablishment, c.lateFeeRule.CreateLateFeeRule)
+	protectedRoutes.GET("/late-fee-rules/:id", staff, ownsLateFeeRule, c.lateFeeRule.GetLateFeeRuleByID)
+	protectedRoutes.PUT("/late-fee-rules/:id", establishmentAdmin, ownsLateFeeRule, c.lateFeeRule.UpdateLateFeeRule)
+	protectedRoutes.DELETE("/late-fee-rules/:id", establishmentAdmin, ownsLateFeeRule, c.lateFeeRule.DeleteLateFeeRule)
+	protectedRoutes.GET("/late-fee-rules", staff, c.lateFeeRule.GetAllLateFeeRules)
+	protectedRoutes.GET("/establishments/:establishment_id/late-fee-rules", staff, ownsEstablishment, c.lateFeeRule.GetLateFeeRulesByEstablishmentID)
+
+	// Installment Routes
+	protectedRoutes.POST("/installments", establishmentAdmin, bodyAccount, c.installment.CreateInstallment)
+	protectedRoutes.GET("/installments/:id", staffOrClient, ownsInstallment, c.installment.GetInstallmentByID)
+	protectedRoutes.PUT("/installments/:id", establishmentAdmin, ownsInstallment, c.installment.UpdateInstallment)
+	protectedRoutes.DELETE("/installments/:id", establishmentAdmin, ownsInstallment, c.installment.DeleteInstallment)
+	protectedRoutes.GET("/credit-accounts/:id/installments", staffOrClient, ownsAccount, c.installment.GetInstallmentsByCreditAccountID)
+	protectedRoutes.GET("/credit-accounts/:id/installments/overdue", staffOrClient, ownsAccount, c.installment.GetOverdueInstallments)
+	protectedRoutes.POST("/credit-accounts/:id/installments/regenerate", establishmentAdmin, ownsAccount, c.creditAccount.RegenerateSchedule)
+
+	// Statement Routes
+	protectedRoutes.POST("/credit-accounts/:id/statements", establishmentAdmin, ownsAccount, c.statement.CloseCycle)
+	protectedRoutes.GET("/credit-accounts/:id/statements", staffOrClient, ownsAccount, c.statement.GetStatementsByCreditAccountID)
+	protectedRoutes.GET("/credit-accounts/:id/statements/export", staffOrClient, ownsAccount, c.statement.ExportStatement)
+	protectedRoutes.GET("/credit-accounts/:id/statements/:statement_id", staffOrClient, ownsAccount, c.statement.GetStatement)
+	protectedRoutes.POST("/establishments/:establishment_id/statements/close", establishmentAdmin, ownsEstablishment, c.statement.CloseCyclesForEstablishment)
+
+	// Ledger Routes
+	protectedRoutes.GET("/establishments/:establishment_id/ledger-accounts", establishmentAdmin, ownsEstablishment, c.ledger.GetChart)
+	protectedRoutes.GET("/establishments/:establishment_id/trial-balance", establishmentAdmin, ownsEstablishment, c.ledger.GetTrialBalance)
+	protectedRoutes.GET("/credit-accounts/:id/journal-entries", establishmentAdmin, ownsAccount, c.ledger.GetJournalEntries)
+	protectedRoutes.GET("/credit-accounts/:id/ledger-balance", establishmentAdmin, ownsAccount, c.ledger.ValidateCreditAccount)
+
+	// Reconciliation Routes
+	protectedRoutes.POST("/establishments/:establishment_id/reconcile", establishmentAdmin, ownsEstablishment, c.reconciliation.Reconcile)
+
+	// Scheduled Job Routes
+	protectedRoutes.GET("/jobs", platformAdmin, c.job.GetAllJobs)
+	protectedRoutes.GET("/jobs/:name/runs", platformAdmin, c.job.GetJobRuns)
+	protectedRoutes.PUT("/jobs/:name/pause", platformAdmin, c.job.PauseJob)
+	protectedRoutes.PUT("/jobs/:name/resume", platformAdmin, c.job.ResumeJob)
+	protectedRoutes.POST("/jobs/:name/trigger", platformAdmin, c.job.TriggerJob)
+
+	// Role Routes
+	protectedRoutes.GET("/roles", platformAdmin, c.role.GetAllRoles)
+	protectedRoutes.PUT("/users/:id/roles", platformAdmin, c.role.AssignRoles)
+
+	// Audit Routes
+	protectedRoutes.GET("/audit-logs", establishmentAdmin, c.audit.GetAuditLogs)
+	protectedRoutes.GET("/audit-logs/verify", platformAdmin, c.audit.VerifyAuditLog)
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"ApiRestFinance/internal/auth"
+	"ApiRestFinance/internal/middleware"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/util"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const testJwtSecret = "test-secret"
+
+const (
+	establishmentA uint = 1
+	establishmentB uint = 2
+)
+
+// unscopedRoutes do not address the data of an establishment: creating a client, the caller's
+// own session, and the lists, which the repositories scope to the tenant.
+var unscopedRoutes = map[string]bool{
+	"POST /api/v1/clients":        true,
+	"GET /api/v1/clients":         true,
+	"GET /api/v1/products":        true,
+	"GET /api/v1/late-fee-rules":  true,
+	"GET /api/v1/audit-logs":      true,
+	"POST /api/v1/reset-password": true,
+	"POST /api/v1/logout-all":     true,
+}
+
+// platformRoutes are only open to platform admins, everyone else gets 403.
+var platformRoutes = map[string]bool{
+	"POST /api/v1/establishments":                     true,
+	"DELETE /api/v1/establishments/:establishment_id": true,
+	"POST /api/v1/admins":                             true,
+	"GET /api/v1/admins":                              true,
+	"GET /api/v1/admins/:id":                          true,
+	"PUT /api/v1/admins/:id":                          true,
+	"DELETE /api/v1/admins/:id":                       true,
+	"GET /api/v1/jobs":                                true,
+	"GET /api/v1/jobs/:name/runs":                     true,
+	"PUT /api/v1/jobs/:name/pause":                    true,
+	"PUT /api/v1/jobs/:name/resume":                   true,
+	"POST /api/v1/jobs/:name/trigger":                 true,
+	"GET /api/v1/roles":                               true,
+	"PUT /api/v1/users/:id/roles":                     true,
+	"GET /api/v1/audit-logs/verify":                   true,
+}
+
+// fakeTenantRepository gives every record to the establishment whose ID equals the record ID,
+// so the IDs of a request name the establishment owning what it addresses.
+type fakeTenantRepository struct{}
+
+func (fakeTenantRepository) Owns(tenant repository.Tenant, resource repository.Resource, id uint) error {
+	if tenant.Unrestricted() || id == tenant.EstablishmentID {
+		return nil
+	}
+	return gorm.ErrRecordNotFound
+}
+
+// newTestRouter serves the protected routes with controllers that have no services. A request
+// the guards let through panics in its handler, which the recovery answers with 418.
+func newTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, _ any) {
+		c.AbortWithStatus(http.StatusTeapot)
+	}))
+	protectedRoutes := router.Group("/api/v1", middleware.AuthMiddleware(testJwtSecret))
+	registerProtectedRoutes(protectedRoutes, apiControllers{}, fakeTenantRepository{}, nil)
+	return router
+}
+
+// serveAs sends a request for a route with every ID, in the path and in the body, pointing at
+// the records of establishmentID.
+func serveAs(t *testing.T, router *gin.Engine, principal auth.Principal, route gin.RouteInfo, establishmentID uint) int {
+	t.Helper()
+	token, err := util.GenerateAccessToken(principal, testJwtSecret)
+	if err != nil {
+		t.Fatalf("error generating access token: %v", err)
+	}
+
+	id := strconv.FormatUint(uint64(establishmentID), 10)
+	segments := strings.Split(route.Path, "/")
+	for i, segment := range segments {
+		switch segment {
+		case ":id", ":establishment_id", ":client_id":
+			segments[i] = id
+		case ":name":
+			segments[i] = "apply-interest"
+		default:
+			if strings.HasPrefix(segment, ":") {
+				segments[i] = "1"
+			}
+		}
+	}
+	body := `{"establishment_id":` + id + `,"client_id":` + id + `,"credit_account_id":` + id + `}`
+
+	req := httptest.NewRequest(route.Method, strings.Join(segments, "/"), strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.IdempotencyKeyHeader, "isolation-test")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func establishmentAdminOf(establishmentID uint) auth.Principal {
+	return auth.Principal{UserID: 10, Roles: []enums.RoleName{enums.EstablishmentAdminRole}, EstablishmentID: establishmentID}
+}
+
+func TestRoutesHideOtherEstablishments(t *testing.T) {
+	router := newTestRouter()
+	for _, route := range router.Routes() {
+		key := route.Method + " " + route.Path
+		if unscopedRoutes[key] {
+			continue
+		}
+		want := http.StatusNotFound
+		if platformRoutes[key] {
+			want = http.StatusForbidden
+		}
+		if got := serveAs(t, router, establishmentAdminOf(establishmentA), route, establishmentB); got != want {
+			t.Errorf("%s: admin of establishment A on establishment B got %d, want %d", key, got, want)
+		}
+	}
+}
+
+func TestRoutesServeOwnEstablishment(t *testing.T) {
+	router := newTestRouter()
+	for _, route := range router.Routes() {
+		key := route.Method + " " + route.Path
+		if unscopedRoutes[key] || platformRoutes[key] {
+			continue
+		}
+		got := serveAs(t, router, establishmentAdminOf(establishmentA), route, establishmentA)
+		if got == http.StatusNotFound || got == http.StatusForbidden {
+			t.Errorf("%s: admin of establishment A on its own data got %d", key, got)
+		}
+	}
+}
+
+func TestStaffCannotAddClientsOfOtherEstablishments(t *testing.T) {
+	router := newTestRouter()
+	path := "/api/v1/establishments/:establishment_id/clients/" + strconv.FormatUint(uint64(establishmentB), 10)
+	route := gin.RouteInfo{Method: http.MethodPut, Path: path}
+	if got := serveAs(t, router, establishmentAdminOf(establishmentA), route, establishmentA); got != http.StatusNotFound {
+		t.Errorf("admin of establishment A adding a client of establishment B got %d, want %d", got, http.StatusNotFound)
+	}
+}
+
+func TestOnlyPlatformAdminsRegisterEstablishments(t *testing.T) {
+	router := newTestRouter()
+	route := gin.RouteInfo{Method: http.MethodPost, Path: "/api/v1/establishments"}
+	forbidden := map[enums.RoleName]bool{
+		enums.ClientRole:             true,
+		enums.CashierRole:            true,
+		enums.EstablishmentAdminRole: true,
+		enums.PlatformAdminRole:      false,
+	}
+	for role, want := range forbidden {
+		principal := auth.Principal{UserID: 12, Roles: []enums.RoleName{role}}
+		if role == enums.CashierRole || role == enums.EstablishmentAdminRole {
+			principal.EstablishmentID = establishmentA
+		}
+		if got := serveAs(t, router, principal, route, establishmentA) == http.StatusForbidden; got != want {
+			t.Errorf("%s registering an establishment: forbidden = %v, want %v", role, got, want)
+		}
+	}
+}
+
+func TestCashierCannotReachOtherEstablishments(t *testing.T) {
+	router := newTestRouter()
+	cashier := auth.Principal{UserID: 11, Roles: []enums.RoleName{enums.CashierRole}, EstablishmentID: establishmentA}
+	for _, route := range router.Routes() {
+		key := route.Method + " " + route.Path
+		if unscopedRoutes[key] {
+			continue
+		}
+		// Routes of establishment admins answer 403 to cashiers before looking at the data
+		if got := serveAs(t, router, cashier, route, establishmentB); got != http.StatusNotFound && got != http.StatusForbidden {
+			t.Errorf("%s: cashier of establishment A on establishment B got %d", key, got)
+		}
+	}
+}
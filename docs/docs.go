@@ -37,13 +37,57 @@ const docTemplate = `{
                     "Admins"
                 ],
                 "summary": "Get all admins",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, created_at (default id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of creation (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of creation (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.AdminResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_AdminResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -297,9 +341,147 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/audit-logs": {
+            "get": {
+                "description": "Lists the state-changing requests, newest first. Establishment admins only see the entries of their establishment.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Get audit log entries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User who made the request",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Establishment the entity belongs to",
+                        "name": "establishment_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Method and route, e.g. PUT /api/v1/credit-accounts/:id",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entity type, e.g. credit-accounts",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, created_at (default -id)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PageResponse-response_AuditLogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/audit-logs/verify": {
+            "get": {
+                "description": "Recomputes the hash chain of the audit log and reports the first entry that was changed, or that follows a removed entry.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Verify the audit log",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.AuditVerificationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/clients": {
             "get": {
-                "description": "Gets a list of all clients.",
+                "description": "Gets the clients of the caller's establishment, or every client for platform admins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -310,13 +492,69 @@ const docTemplate = `{
                     "Clients"
                 ],
                 "summary": "Get all clients",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, email, credit_limit, created_at (default id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of registration (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of registration (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum credit limit",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum credit limit",
+                        "name": "max_amount",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.ClientResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_ClientResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -382,50 +620,6 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/clients/{clientID}/credit-accounts": {
-            "get": {
-                "description": "Retrieves all credit accounts associated with a client.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "CreditAccounts"
-                ],
-                "summary": "Get credit accounts by client ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Client ID",
-                        "name": "clientID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.CreditAccountResponse"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
         "/api/v1/clients/{id}": {
             "get": {
                 "description": "Gets a client by its ID.",
@@ -614,35 +808,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-accounts": {
-            "post": {
-                "description": "Creates a new credit account for a client.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/clients/{id}/credit-accounts": {
+            "get": {
+                "description": "Retrieves the credit accounts of a client at the caller's establishment, or at every establishment for the client itself.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Create a new credit account",
+                "summary": "Get credit accounts by client ID",
                 "parameters": [
                     {
-                        "description": "Credit account details",
-                        "name": "creditAccount",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateCreditAccountRequest"
-                        }
+                        "type": "integer",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, created_at, credit_limit, current_balance (default id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum current balance",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum current balance",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "SHORT_TERM",
+                            "LONG_TERM"
+                        ],
+                        "type": "string",
+                        "description": "Credit type",
+                        "name": "credit_type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.CreditAccountResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_CreditAccountResponse"
                         }
                     },
                     "400": {
@@ -651,6 +898,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -660,35 +913,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-accounts/{creditAccountID}/clients/{clientID}": {
-            "put": {
-                "description": "Assigns an existing credit account to a client.",
-                "produces": [
+        "/api/v1/credit-accounts": {
+            "post": {
+                "description": "Creates a new credit account for a client.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Assign credit account to client",
+                "summary": "Create a new credit account",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Credit Account ID",
-                        "name": "creditAccountID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Client ID",
-                        "name": "clientID",
-                        "in": "path",
-                        "required": true
+                        "description": "Credit account details",
+                        "name": "creditAccount",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateCreditAccountRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.CreditAccountResponse"
                         }
@@ -699,12 +950,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -714,16 +959,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-accounts/{creditAccountID}/installments": {
-            "get": {
-                "description": "Retrieves all installments for a specific credit account.",
+        "/api/v1/credit-accounts/{creditAccountID}/clients/{clientID}": {
+            "put": {
+                "description": "Assigns an existing credit account to a client.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Installments"
+                    "CreditAccounts"
                 ],
-                "summary": "Get installments by credit account ID",
+                "summary": "Assign credit account to client",
                 "parameters": [
                     {
                         "type": "integer",
@@ -731,16 +976,20 @@ const docTemplate = `{
                         "name": "creditAccountID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client ID",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.InstallmentResponse"
-                            }
+                            "$ref": "#/definitions/response.CreditAccountResponse"
                         }
                     },
                     "400": {
@@ -749,6 +998,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -758,21 +1013,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-accounts/{creditAccountID}/installments/overdue": {
+        "/api/v1/credit-accounts/{id}": {
             "get": {
-                "description": "Retrieves all overdue installments for a specific credit account.",
+                "description": "Retrieves a credit account by its ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Installments"
+                    "CreditAccounts"
                 ],
-                "summary": "Get overdue installments by credit account ID",
+                "summary": "Get credit account by ID",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "Credit Account ID",
-                        "name": "creditAccountID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -781,10 +1036,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.InstallmentResponse"
-                            }
+                            "$ref": "#/definitions/response.CreditAccountResponse"
                         }
                     },
                     "400": {
@@ -793,6 +1045,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -800,35 +1058,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/credit-accounts/{creditAccountID}/late-fees": {
-            "get": {
-                "description": "Retrieves all late fees for a specific credit account.",
+            },
+            "put": {
+                "description": "Updates an existing credit account by its ID. A new credit limit is recorded in the history of the account and may not be lower than its balance; lower limits go through a credit limit change approved with an override.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFees"
+                    "CreditAccounts"
                 ],
-                "summary": "Get late fees by credit account ID",
+                "summary": "Update a credit account",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "Credit Account ID",
-                        "name": "creditAccountID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Updated credit account details",
+                        "name": "creditAccount",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateCreditAccountRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.LateFeeResponse"
-                            }
+                            "$ref": "#/definitions/response.CreditAccountResponse"
                         }
                     },
                     "400": {
@@ -837,6 +1102,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -844,42 +1121,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/credit-accounts/{creditAccountID}/payments": {
-            "post": {
-                "description": "Processes a payment transaction on a credit account.",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes a credit account by its ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Process a payment",
+                "summary": "Delete a credit account",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "Credit Account ID",
-                        "name": "creditAccountID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Payment details",
-                        "name": "payment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateTransactionRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Payment processed successfully"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -902,9 +1165,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-accounts/{creditAccountID}/purchases": {
+        "/api/v1/credit-accounts/{id}/adjustments": {
             "post": {
-                "description": "Processes a purchase transaction on a credit account.",
+                "description": "Post a manual ADJUSTMENT of the balance of a credit account. Positive amounts increase the balance.",
                 "consumes": [
                     "application/json"
                 ],
@@ -912,30 +1175,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "CreditAccounts"
+                    "Transactions"
                 ],
-                "summary": "Process a purchase",
+                "summary": "Create Adjustment",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
                     {
                         "type": "integer",
                         "description": "Credit Account ID",
-                        "name": "creditAccountID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Purchase details",
-                        "name": "purchase",
+                        "description": "Adjustment Data",
+                        "name": "adjustment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateTransactionRequest"
+                            "$ref": "#/definitions/request.CreateAdjustmentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Purchase processed successfully"
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransactionResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -949,6 +1222,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -958,9 +1243,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-accounts/{creditAccountID}/transactions": {
+        "/api/v1/credit-accounts/{id}/history": {
             "get": {
-                "description": "Get all transactions for a specific credit account.",
+                "description": "Searches the history of a credit account. Each entry carries the running balance after it; the response adds the balances before and at the end of the period and the totals per transaction type of every matching entry. Amounts are compared by magnitude.",
                 "consumes": [
                     "application/json"
                 ],
@@ -970,24 +1255,81 @@ const docTemplate = `{
                 "tags": [
                     "Transactions"
                 ],
-                "summary": "Get Transaction by Credit Account ID",
+                "summary": "Search Transaction History",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "Credit Account ID",
-                        "name": "creditAccountID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, transaction_date, amount (default transaction_date)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction type",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum amount",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text contained in the description",
+                        "name": "description",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.TransactionResponse"
-                            }
+                            "$ref": "#/definitions/response.TransactionHistoryResponse"
                         }
                     },
                     "400": {
@@ -1011,16 +1353,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-accounts/{id}": {
+        "/api/v1/credit-accounts/{id}/installments": {
             "get": {
-                "description": "Retrieves a credit account by its ID.",
+                "description": "Lists the installments of a credit account.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "CreditAccounts"
+                    "Installments"
                 ],
-                "summary": "Get credit account by ID",
+                "summary": "Get installments by credit account ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1028,13 +1370,67 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, number, due_date, amount (default due_date)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Installment status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum amount",
+                        "name": "max_amount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.CreditAccountResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_InstallmentResponse"
                         }
                     },
                     "400": {
@@ -1043,12 +1439,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1056,19 +1446,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates an existing credit account by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/credit-accounts/{id}/installments/overdue": {
+            "get": {
+                "description": "Lists the overdue installments of a credit account.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "CreditAccounts"
+                    "Installments"
                 ],
-                "summary": "Update a credit account",
+                "summary": "Get overdue installments by credit account ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1078,20 +1467,59 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Updated credit account details",
-                        "name": "creditAccount",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateCreditAccountRequest"
-                        }
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, number, due_date, amount (default due_date)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum amount",
+                        "name": "max_amount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.CreditAccountResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_InstallmentResponse"
                         }
                     },
                     "400": {
@@ -1100,12 +1528,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1113,16 +1535,21 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a credit account by its ID.",
+            }
+        },
+        "/api/v1/credit-accounts/{id}/installments/regenerate": {
+            "post": {
+                "description": "Rebuilds the unpaid installments of a LONG_TERM credit account, optionally with a new term or amortization method.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Delete a credit account",
+                "summary": "Regenerate the installment schedule",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1130,11 +1557,25 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New schedule terms",
+                        "name": "schedule",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/request.RegenerateScheduleRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.InstallmentResponse"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1157,35 +1598,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-requests": {
-            "post": {
-                "description": "Creates a new credit request for a client.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/credit-accounts/{id}/journal-entries": {
+            "get": {
+                "description": "Lists the double-entry postings of every transaction of a credit account, oldest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "CreditRequests"
+                    "Ledger"
                 ],
-                "summary": "Create a credit request",
+                "summary": "Get the journal entries of a credit account",
                 "parameters": [
                     {
-                        "description": "Credit request details",
-                        "name": "creditRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateCreditRequest"
-                        }
+                        "type": "integer",
+                        "description": "Credit Account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, entry_date (default entry_date)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.CreditRequestResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_JournalEntryResponse"
                         }
                     },
                     "400": {
@@ -1203,30 +1675,78 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-requests/{id}": {
+        "/api/v1/credit-accounts/{id}/late-fees": {
             "get": {
-                "description": "Retrieves a credit request by its ID.",
+                "description": "Lists the late fees of a credit account.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "CreditRequests"
+                    "LateFees"
                 ],
-                "summary": "Get credit request by ID",
+                "summary": "Get late fees by credit account ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Credit Request ID",
+                        "description": "Credit Account ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, applied_date, amount (default applied_date)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum amount",
+                        "name": "max_amount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.CreditRequestResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_LateFeeResponse"
                         }
                     },
                     "400": {
@@ -1235,12 +1755,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1250,20 +1764,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-requests/{id}/approve": {
-            "put": {
-                "description": "Approves a pending credit request and creates a credit account.",
+        "/api/v1/credit-accounts/{id}/ledger-balance": {
+            "get": {
+                "description": "Compares the balance and debt components stored on a credit account with its receivables in the ledger.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "CreditRequests"
+                    "Ledger"
                 ],
-                "summary": "Approve credit request",
+                "summary": "Validate a credit account against the ledger",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Credit Request ID",
+                        "description": "Credit Account ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1273,7 +1787,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.CreditAccountResponse"
+                            "$ref": "#/definitions/response.LedgerBalanceResponse"
                         }
                     },
                     "400": {
@@ -1297,68 +1811,90 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/credit-requests/{id}/reject": {
-            "put": {
-                "description": "Rejects a pending credit request.",
+        "/api/v1/credit-accounts/{id}/limit-changes": {
+            "get": {
+                "description": "Lists the credit limit changes requested for a credit account, including the ones proposed by automatic reviews.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "CreditRequests"
+                    "CreditLimitChanges"
                 ],
-                "summary": "Reject credit request",
+                "summary": "Get the credit limit changes of a credit account",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Credit Request ID",
+                        "description": "Credit Account ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Credit request rejected successfully"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, created_at, new_limit (default -created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status (PENDING, APPROVED, REJECTED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum new limit",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum new limit",
+                        "name": "max_amount",
+                        "in": "query"
                     }
-                }
-            }
-        },
-        "/api/v1/establishments": {
-            "get": {
-                "description": "Retrieve a list of all establishments.",
-                "produces": [
-                    "application/json"
                 ],
-                "tags": [
-                    "Establishments"
-                ],
-                "summary": "Get all establishments",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.EstablishmentResponse"
-                            }
+                            "$ref": "#/definitions/response.PageResponse-response_CreditLimitChangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
                     "500": {
@@ -1370,7 +1906,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new establishment with admin details.",
+                "description": "Requests a new credit limit for a credit account. The change is applied once an establishment admin approves it; an account has at most one pending change.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1378,17 +1914,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Establishments"
+                    "CreditLimitChanges"
                 ],
-                "summary": "Create a new establishment",
+                "summary": "Request a credit limit change",
                 "parameters": [
                     {
-                        "description": "Establishment details",
-                        "name": "establishment",
+                        "type": "integer",
+                        "description": "Credit Account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Requested credit limit",
+                        "name": "limitChange",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateEstablishmentRequest"
+                            "$ref": "#/definitions/request.CreateCreditLimitChangeRequest"
                         }
                     }
                 ],
@@ -1396,7 +1939,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/response.EstablishmentResponse"
+                            "$ref": "#/definitions/response.CreditLimitChangeResponse"
                         }
                     },
                     "400": {
@@ -1405,6 +1948,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1414,34 +1975,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{establishmentID}/credit-accounts": {
-            "get": {
-                "description": "Retrieves all credit accounts associated with an establishment.",
+        "/api/v1/credit-accounts/{id}/payments": {
+            "post": {
+                "description": "Processes a payment transaction on a credit account.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Get credit accounts by establishment ID",
+                "summary": "Process a payment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "establishmentID",
+                        "description": "Credit Account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Payment details",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateTransactionRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.CreditAccountResponse"
-                            }
-                        }
+                    "201": {
+                        "description": "Payment processed successfully"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1449,6 +2023,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1458,28 +2050,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{establishmentID}/credit-accounts/apply-interest": {
+        "/api/v1/credit-accounts/{id}/prepayments": {
             "post": {
-                "description": "Applies interest to all eligible credit accounts within an establishment.",
+                "description": "Pays off part or all of the outstanding principal ahead of the schedule. The amount first covers the interest earned since the last due date; the scheduled interest beyond today is rebated and the open installments are replaced by a schedule for the remaining principal that keeps the installment amount (REDUCE_TERM) or the term (REDUCE_INSTALLMENT). Amounts due must be paid with a regular payment first.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Apply interest to all accounts",
+                "summary": "Prepay a LONG_TERM credit account",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "establishmentID",
+                        "description": "Credit Account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Prepayment details",
+                        "name": "prepayment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PrepaymentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Interest applied successfully"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.PrepaymentResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1487,6 +2101,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1496,28 +2128,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{establishmentID}/credit-accounts/apply-late-fees": {
+        "/api/v1/credit-accounts/{id}/purchases": {
             "post": {
-                "description": "Applies late fees to all eligible credit accounts within an establishment.",
+                "description": "Processes a purchase transaction on a credit account.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Apply late fees to all accounts",
+                "summary": "Process a purchase",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "establishmentID",
+                        "description": "Credit Account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Purchase details",
+                        "name": "purchase",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateTransactionRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Late fees applied successfully"
+                    "201": {
+                        "description": "Purchase processed successfully"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1525,6 +2176,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1534,33 +2203,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{establishmentID}/credit-accounts/debt-summary": {
-            "get": {
-                "description": "Retrieves a summary of debts owed to an establishment.",
+        "/api/v1/credit-accounts/{id}/purchases/itemized": {
+            "post": {
+                "description": "Charges products of the establishment of a credit account. Each item may override the catalog unit price. Products must be active and have enough stock, which is decremented together with the balance. The lines are shown in the transaction and in the account history.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "CreditAccounts"
                 ],
-                "summary": "Get admin debt summary",
+                "summary": "Process an itemized purchase",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "establishmentID",
+                        "description": "Credit Account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Purchase items",
+                        "name": "purchase",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreatePurchaseRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.AdminDebtSummary"
-                            }
+                            "$ref": "#/definitions/response.TransactionResponse"
                         }
                     },
                     "400": {
@@ -1569,6 +2254,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1578,33 +2281,78 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{establishmentID}/credit-requests/pending": {
+        "/api/v1/credit-accounts/{id}/statements": {
             "get": {
-                "description": "Retrieves all pending credit requests for an establishment.",
+                "description": "Lists the statements of a credit account, latest first. The date filters apply to the closing date.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "CreditRequests"
+                    "Statements"
                 ],
-                "summary": "Get pending credit requests",
+                "summary": "Get statements by credit account ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "establishmentID",
+                        "description": "Credit Account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, period_end, closing_balance (default -period_end)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum closing balance",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum closing balance",
+                        "name": "max_amount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.CreditRequestResponse"
-                            }
+                            "$ref": "#/definitions/response.PageResponse-response_StatementResponse"
                         }
                     },
                     "400": {
@@ -1620,35 +2368,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/establishments/{establishmentID}/late-fee-rules": {
-            "get": {
-                "description": "Retrieves all late fee rules for a specific establishment.",
+            },
+            "post": {
+                "description": "Closes the latest billing cycle of a SHORT_TERM credit account and stores its statement.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFeeRules"
+                    "Statements"
                 ],
-                "summary": "Get late fee rules by establishment ID",
+                "summary": "Close the billing cycle of a credit account",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "establishmentID",
+                        "description": "Credit Account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.LateFeeRuleResponse"
-                            }
+                            "$ref": "#/definitions/response.StatementResponse"
                         }
                     },
                     "400": {
@@ -1657,6 +2400,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1666,33 +2421,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{establishment_id}/products": {
+        "/api/v1/credit-accounts/{id}/statements/export": {
             "get": {
-                "description": "Retrieve products associated with a specific establishment ID.",
+                "description": "Renders the statement of a credit account for a date range as PDF (default) or CSV.",
                 "produces": [
-                    "application/json"
+                    "application/pdf",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Products"
+                    "Statements"
                 ],
-                "summary": "Get products by establishment ID",
+                "summary": "Export an account statement",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "establishment_id",
+                        "description": "Credit Account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pdf",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.ProductResponse"
-                            }
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1701,6 +2478,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1710,81 +2493,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{id}": {
+        "/api/v1/credit-accounts/{id}/statements/{statement_id}": {
             "get": {
-                "description": "Retrieve an establishment by its ID.",
+                "description": "Retrieves a statement of a credit account by its ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Establishments"
+                    "Statements"
                 ],
-                "summary": "Get an establishment by ID",
+                "summary": "Get a statement",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
+                        "description": "Credit Account ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.EstablishmentResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    }
-                }
-            },
-            "put": {
-                "description": "Update an existing establishment by ID.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Establishments"
-                ],
-                "summary": "Update an existing establishment",
-                "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "id",
+                        "description": "Statement ID",
+                        "name": "statement_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Establishment details",
-                        "name": "establishment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateEstablishmentRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.EstablishmentResponse"
+                            "$ref": "#/definitions/response.StatementResponse"
                         }
                     },
                     "400": {
@@ -1806,73 +2545,96 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete an establishment by ID.",
+            }
+        },
+        "/api/v1/credit-accounts/{id}/transactions": {
+            "get": {
+                "description": "Lists the transactions of a credit account.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Establishments"
+                    "Transactions"
                 ],
-                "summary": "Delete an establishment",
+                "summary": "Get Transaction by Credit Account ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
+                        "description": "Credit Account ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/establishments/{id}/clients/{client_id}": {
-            "put": {
-                "description": "Associate a client with an establishment.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Establishments"
-                ],
-                "summary": "Add a client to an establishment",
-                "parameters": [
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, transaction_date, amount (default transaction_date)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction type",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum amount",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text contained in the description",
+                        "name": "description",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PageResponse-response_TransactionResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1895,9 +2657,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/establishments/{id}/products": {
+        "/api/v1/credit-accounts/{id}/write-off": {
             "post": {
-                "description": "Associate a list of products with an establishment.",
+                "description": "Charge the whole outstanding balance of a credit account to bad debt and block the account.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1905,33 +2667,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Establishments"
+                    "Transactions"
                 ],
-                "summary": "Register products for an establishment",
+                "summary": "Write Off Credit Account",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
                     {
                         "type": "integer",
-                        "description": "Establishment ID",
+                        "description": "Credit Account ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "List of product IDs",
-                        "name": "products",
+                        "description": "Write-off Data",
+                        "name": "writeOff",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
+                            "$ref": "#/definitions/request.WriteOffRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransactionResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1945,6 +2714,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1954,9 +2735,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/installments": {
+        "/api/v1/credit-requests": {
             "post": {
-                "description": "Creates a new installment for a credit account.",
+                "description": "Creates a new credit request for a client. The client is scored from their history across establishments and the credit policy of the establishment approves, rejects or leaves the request pending for manual review; the score and the reasons of the decision are stored on the request.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1964,17 +2745,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Installments"
+                    "CreditRequests"
                 ],
-                "summary": "Create a new installment",
+                "summary": "Create a credit request",
                 "parameters": [
                     {
-                        "description": "Installment details",
-                        "name": "installment",
+                        "description": "Credit request details",
+                        "name": "creditRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateInstallmentRequest"
+                            "$ref": "#/definitions/request.CreateCreditRequest"
                         }
                     }
                 ],
@@ -1982,7 +2763,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/response.InstallmentResponse"
+                            "$ref": "#/definitions/response.CreditRequestResponse"
                         }
                     },
                     "400": {
@@ -2000,20 +2781,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/installments/{id}": {
+        "/api/v1/credit-requests/{id}": {
             "get": {
-                "description": "Retrieves an installment by its ID.",
+                "description": "Retrieves a credit request by its ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Installments"
+                    "CreditRequests"
                 ],
-                "summary": "Get installment by ID",
+                "summary": "Get credit request by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Installment ID",
+                        "description": "Credit Request ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2023,7 +2804,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.InstallmentResponse"
+                            "$ref": "#/definitions/response.CreditRequestResponse"
                         }
                     },
                     "400": {
@@ -2045,42 +2826,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/credit-requests/{id}/approve": {
             "put": {
-                "description": "Updates an existing installment by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Approves a pending credit request and creates a credit account.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Installments"
+                    "CreditRequests"
                 ],
-                "summary": "Update an installment",
+                "summary": "Approve credit request",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Installment ID",
+                        "description": "Credit Request ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated installment details",
-                        "name": "installment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateInstallmentRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.InstallmentResponse"
+                            "$ref": "#/definitions/response.CreditAccountResponse"
                         }
                     },
                     "400": {
@@ -2089,6 +2860,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2102,28 +2879,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an installment by its ID.",
+            }
+        },
+        "/api/v1/credit-requests/{id}/reject": {
+            "put": {
+                "description": "Rejects a pending credit request.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Installments"
+                    "CreditRequests"
                 ],
-                "summary": "Delete an installment",
+                "summary": "Reject credit request",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Installment ID",
+                        "description": "Credit Request ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Credit request rejected successfully"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2131,6 +2910,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2146,23 +2931,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/late-fee-rules": {
+        "/api/v1/establishments": {
             "get": {
-                "description": "Retrieves all late fee rules.",
+                "description": "Retrieve a list of all establishments.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFeeRules"
+                    "Establishments"
                 ],
-                "summary": "Get all late fee rules",
+                "summary": "Get all establishments",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/response.LateFeeRuleResponse"
+                                "$ref": "#/definitions/response.EstablishmentResponse"
                             }
                         }
                     },
@@ -2175,7 +2960,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new late fee rule for an establishment (or globally).",
+                "description": "Create a new establishment with admin details.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2183,17 +2968,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LateFeeRules"
+                    "Establishments"
                 ],
-                "summary": "Create a late fee rule",
+                "summary": "Create a new establishment",
                 "parameters": [
                     {
-                        "description": "Late fee rule details",
-                        "name": "lateFeeRule",
+                        "description": "Establishment details",
+                        "name": "establishment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateLateFeeRuleRequest"
+                            "$ref": "#/definitions/request.CreateEstablishmentRequest"
                         }
                     }
                 ],
@@ -2201,7 +2986,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/response.LateFeeRuleResponse"
+                            "$ref": "#/definitions/response.EstablishmentResponse"
                         }
                     },
                     "400": {
@@ -2219,21 +3004,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/late-fee-rules/{id}": {
+        "/api/v1/establishments/{establishment_id}": {
             "get": {
-                "description": "Retrieves a late fee rule by its ID.",
+                "description": "Retrieve an establishment by its ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFeeRules"
+                    "Establishments"
                 ],
-                "summary": "Get late fee rule by ID",
+                "summary": "Get an establishment by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Late Fee Rule ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
                     }
@@ -2242,13 +3027,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.LateFeeRuleResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.EstablishmentResponse"
                         }
                     },
                     "404": {
@@ -2266,7 +3045,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing late fee rule by its ID.",
+                "description": "Update an existing establishment by ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2274,24 +3053,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LateFeeRules"
+                    "Establishments"
                 ],
-                "summary": "Update a late fee rule",
+                "summary": "Update an existing establishment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Late Fee Rule ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated late fee rule details",
-                        "name": "lateFeeRule",
+                        "description": "Establishment details",
+                        "name": "establishment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateLateFeeRuleRequest"
+                            "$ref": "#/definitions/request.UpdateEstablishmentRequest"
                         }
                     }
                 ],
@@ -2299,7 +3078,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.LateFeeRuleResponse"
+                            "$ref": "#/definitions/response.EstablishmentResponse"
                         }
                     },
                     "400": {
@@ -2323,19 +3102,19 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a late fee rule by its ID.",
+                "description": "Delete an establishment by ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFeeRules"
+                    "Establishments"
                 ],
-                "summary": "Delete a late fee rule",
+                "summary": "Delete an establishment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Late Fee Rule ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
                     }
@@ -2344,12 +3123,6 @@ const docTemplate = `{
                     "204": {
                         "description": "No Content"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2365,36 +3138,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/late-fees": {
-            "post": {
-                "description": "Creates a new late fee for a credit account.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/establishments/{establishment_id}/clients/{client_id}": {
+            "put": {
+                "description": "Associate a client with an establishment. Staff may only add clients who have a credit request or a credit account with their establishment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFees"
+                    "Establishments"
                 ],
-                "summary": "Create a late fee",
+                "summary": "Add a client to an establishment",
                 "parameters": [
                     {
-                        "description": "Late fee details",
-                        "name": "lateFee",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateLateFeeRequest"
-                        }
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/response.LateFeeResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2402,6 +3174,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2411,30 +3189,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/late-fees/{id}": {
+        "/api/v1/establishments/{establishment_id}/credit-accounts": {
             "get": {
-                "description": "Retrieves a late fee by its ID.",
+                "description": "Lists the credit accounts of an establishment. The date filters apply to the opening date.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFees"
+                    "CreditAccounts"
                 ],
-                "summary": "Get late fee by ID",
+                "summary": "Get credit accounts by establishment ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Late Fee ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, created_at, credit_limit, current_balance (default id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum current balance",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum current balance",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "SHORT_TERM",
+                            "LONG_TERM"
+                        ],
+                        "type": "string",
+                        "description": "Credit type",
+                        "name": "credit_type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.LateFeeResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_CreditAccountResponse"
                         }
                     },
                     "400": {
@@ -2443,12 +3279,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2456,43 +3286,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates an existing late fee by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/establishments/{establishment_id}/credit-accounts/apply-delinquency-policy": {
+            "post": {
+                "description": "Blocks and unblocks the credit accounts of an establishment as its delinquency policy calls for, recording each change with its reason in the account history. The policy also runs on a schedule.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFees"
+                    "DelinquencyPolicies"
                 ],
-                "summary": "Update a late fee",
+                "summary": "Apply the delinquency policy of an establishment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Late Fee ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated late fee details",
-                        "name": "lateFee",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateLateFeeRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.LateFeeResponse"
-                        }
+                        "description": "Delinquency policy applied successfully"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2500,12 +3317,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2513,28 +3324,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a late fee by its ID.",
+            }
+        },
+        "/api/v1/establishments/{establishment_id}/credit-accounts/apply-interest": {
+            "post": {
+                "description": "Applies interest to all eligible credit accounts within an establishment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LateFees"
+                    "CreditAccounts"
                 ],
-                "summary": "Delete a late fee",
+                "summary": "Apply interest to all accounts",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Late Fee ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Interest applied successfully"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2542,12 +3355,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2557,69 +3364,79 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/login": {
+        "/api/v1/establishments/{establishment_id}/credit-accounts/apply-late-fees": {
             "post": {
-                "description": "Logs in with user credentials",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Applies late fees to all eligible credit accounts within an establishment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "CreditAccounts"
                 ],
-                "summary": "Login",
+                "summary": "Apply late fees to all accounts",
                 "parameters": [
                     {
-                        "description": "User credentials",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.LoginRequest"
-                        }
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Late fees applied successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.AuthResponse"
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/products": {
+        "/api/v1/establishments/{establishment_id}/credit-accounts/debt-summary": {
             "get": {
-                "description": "Retrieve a list of all products.",
+                "description": "Retrieves a summary of debts owed to an establishment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "CreditAccounts"
                 ],
-                "summary": "Get all products",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/response.ProductResponse"
-                            }
-                        }
-                    },
+                "summary": "Get admin debt summary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.AdminDebtSummary"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2627,36 +3444,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/establishments/{establishment_id}/credit-accounts/review-limits": {
             "post": {
-                "description": "Create a new product associated with an establishment.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Scores the clients of every unblocked credit account of an establishment and proposes the limit changes its credit policy calls for. Proposals are left pending for approval. The review also runs on a schedule.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "CreditLimitChanges"
                 ],
-                "summary": "Create a new product",
+                "summary": "Review the credit limits of an establishment",
                 "parameters": [
                     {
-                        "description": "Product details",
-                        "name": "product",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateProductRequest"
-                        }
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/response.ProductResponse"
-                        }
+                    "200": {
+                        "description": "Credit limits reviewed successfully"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2673,21 +3484,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/products/{id}": {
+        "/api/v1/establishments/{establishment_id}/credit-policy": {
             "get": {
-                "description": "Retrieve a product by its ID.",
+                "description": "Gets the thresholds used to decide the credit requests of an establishment from the credit score of the client.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "CreditPolicies"
                 ],
-                "summary": "Get a product by ID",
+                "summary": "Get the credit policy of an establishment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
                     }
@@ -2696,7 +3507,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ProductResponse"
+                            "$ref": "#/definitions/response.CreditPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
                     "404": {
@@ -2714,7 +3531,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing product by ID.",
+                "description": "Sets the thresholds used to decide new credit requests. Scores range from 0 to 1000; requests scoring at least auto_approve_min_score are approved, those below auto_reject_below_score are rejected and the rest are left for manual review, as are requests above max_auto_approve_limit and requests asking for a TEA below min_interest_rate, a grace period longer than max_grace_period or a LONG_TERM term longer than max_term.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2722,24 +3539,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "CreditPolicies"
                 ],
-                "summary": "Update an existing product",
+                "summary": "Set the credit policy of an establishment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Product details",
-                        "name": "product",
+                        "description": "Credit policy",
+                        "name": "creditPolicy",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateProductRequest"
+                            "$ref": "#/definitions/request.UpdateCreditPolicyRequest"
                         }
                     }
                 ],
@@ -2747,7 +3564,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ProductResponse"
+                            "$ref": "#/definitions/response.CreditPolicyResponse"
                         }
                     },
                     "400": {
@@ -2769,31 +3586,94 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a product by ID.",
+            }
+        },
+        "/api/v1/establishments/{establishment_id}/credit-requests/pending": {
+            "get": {
+                "description": "Lists the pending credit requests of an establishment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "CreditRequests"
                 ],
-                "summary": "Delete a product",
+                "summary": "Get pending credit requests",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, created_at, requested_credit_limit (default created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum requested credit limit",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum requested credit limit",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "SHORT_TERM",
+                            "LONG_TERM"
+                        ],
+                        "type": "string",
+                        "description": "Credit type",
+                        "name": "credit_type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PageResponse-response_CreditRequestResponse"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -2807,25 +3687,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/refresh": {
-            "post": {
-                "description": "Generates a new access token from a valid refresh token.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/establishments/{establishment_id}/delinquency-policy": {
+            "get": {
+                "description": "Gets the rules used to block and unblock the credit accounts of an establishment from their arrears.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "DelinquencyPolicies"
                 ],
-                "summary": "Refresh access token",
+                "summary": "Get the delinquency policy of an establishment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Bearer {refreshToken}",
-                        "name": "Authorization",
-                        "in": "header",
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -2833,24 +3710,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.AuthResponse"
+                            "$ref": "#/definitions/response.DelinquencyPolicyResponse"
                         }
                     },
-                    "401": {
-                        "description": "Invalid or expired refresh token.",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/register": {
-            "post": {
-                "description": "Registers a new user with the provided data",
+            },
+            "put": {
+                "description": "Sets the rules applied by the delinquency batch job. Accounts overdue at least block_after_days_overdue days are blocked; with unblock_when_cleared, accounts the policy blocked are unblocked once they have no overdue installments or balance. Blocks placed by staff or write-offs are never lifted automatically.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2858,133 +3742,147 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "DelinquencyPolicies"
                 ],
-                "summary": "Register a new user",
+                "summary": "Set the delinquency policy of an establishment",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Delinquency policy",
+                        "name": "delinquencyPolicy",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateUserRequest"
+                            "$ref": "#/definitions/request.UpdateDelinquencyPolicyRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.DelinquencyPolicyResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/reset-password": {
-            "post": {
-                "description": "Resets the password for the authenticated user, requiring the current password",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/establishments/{establishment_id}/late-fee-rules": {
+            "get": {
+                "description": "Lists the late fee rules of an establishment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "LateFeeRules"
                 ],
-                "summary": "Reset password",
+                "summary": "Get late fee rules by establishment ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Bearer {accessToken}",
-                        "name": "Authorization",
-                        "in": "header",
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Reset password request",
-                        "name": "reset",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ResetPasswordRequest"
-                        }
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, name, days_overdue_min (default id)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.PageResponse-response_LateFeeRuleResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/transactions": {
-            "post": {
-                "description": "Create a new transaction for a credit account.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/establishments/{establishment_id}/ledger-accounts": {
+            "get": {
+                "description": "Lists the ledger accounts of an establishment, creating the default chart on first use.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Ledger"
                 ],
-                "summary": "Create Transaction",
+                "summary": "Get the chart of accounts of an establishment",
                 "parameters": [
                     {
-                        "description": "Transaction Data",
-                        "name": "transaction",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateTransactionRequest"
-                        }
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.TransactionResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.LedgerAccountResponse"
+                            }
                         }
                     },
                     "400": {
@@ -2993,6 +3891,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3002,33 +3906,84 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/transactions/{id}": {
+        "/api/v1/establishments/{establishment_id}/products": {
             "get": {
-                "description": "Get a transaction by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve products associated with a specific establishment ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Products"
                 ],
-                "summary": "Get Transaction by ID",
+                "summary": "Get products by establishment ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Transaction ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, name, price, stock, created_at (default id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product category",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price",
+                        "name": "max_amount",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.TransactionResponse"
+                            "$ref": "#/definitions/response.PageResponse-response_ProductResponse"
                         }
                     },
                     "400": {
@@ -3037,12 +3992,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3051,8 +4000,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update a transaction by its ID.",
+            "post": {
+                "description": "Associate a list of products with an establishment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3060,33 +4009,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Establishments"
                 ],
-                "summary": "Update Transaction",
+                "summary": "Register products for an establishment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Transaction ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Transaction Data",
-                        "name": "transaction",
+                        "description": "List of product IDs",
+                        "name": "products",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateTransactionRequest"
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.TransactionResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3107,33 +4056,129 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a transaction by its ID.",
-                "consumes": [
+            }
+        },
+        "/api/v1/establishments/{establishment_id}/reconcile": {
+            "post": {
+                "description": "Rebuilds the balance of every credit account of an establishment from its history and reports the accounts whose stored balance, transactions or ledger disagree. With dry_run=false, stored balances are corrected with an ADJUSTMENT.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Reconcile the balances of an establishment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report the discrepancies (default true)",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/establishments/{establishment_id}/statements/close": {
+            "post": {
+                "description": "Closes the latest billing cycle of every SHORT_TERM credit account within an establishment.",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "Statements"
+                ],
+                "summary": "Close the billing cycles of an establishment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.StatementResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/establishments/{establishment_id}/trial-balance": {
+            "get": {
+                "description": "Sums the debits and credits posted to every ledger account of an establishment up to a date.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Ledger"
                 ],
-                "summary": "Delete Transaction",
+                "summary": "Get the trial balance of an establishment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Transaction ID",
-                        "name": "id",
+                        "description": "Establishment ID",
+                        "name": "establishment_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD), defaults to today",
+                        "name": "as_of",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.TransactionResponse"
+                            "$ref": "#/definitions/response.TrialBalanceResponse"
                         }
                     },
                     "400": {
@@ -3156,642 +4201,4537 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "entities.CreditRequestStatus": {
-            "type": "string",
-            "enum": [
-                "PENDING",
-                "APPROVED",
-                "REJECTED"
-            ],
-            "x-enum-varnames": [
-                "Pending",
-                "Approved",
-                "Rejected"
-            ]
-        },
-        "entities.ProductCategory": {
-            "type": "string",
-            "enum": [
-                "Grocery",
-                "FruitAndVeg",
-                "Meat",
-                "Poultry",
-                "Seafood",
-                "Bakery",
-                "Liquor",
-                "GeneralStore"
-            ],
-            "x-enum-varnames": [
-                "ProductCategoryGrocery",
-                "ProductCategoryFruitAndVeg",
-                "ProductCategoryMeat",
-                "ProductCategoryPoultry",
-                "ProductCategorySeafood",
-                "ProductCategoryBakery",
-                "ProductCategoryLiquor",
-                "ProductCategoryGeneralStore"
-            ]
         },
-        "enums.CreditType": {
-            "type": "string",
-            "enum": [
-                "SHORT_TERM",
-                "LONG_TERM"
-            ],
-            "x-enum-varnames": [
-                "ShortTerm",
-                "LongTerm"
-            ]
+        "/api/v1/forgot-password": {
+            "post": {
+                "description": "Emails a single-use link to reset the password, valid for one hour. The answer is the same whether the email has an account or not.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Forgot password",
+                "parameters": [
+                    {
+                        "description": "Email of the account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/forgot-password/confirm": {
+            "post": {
+                "description": "Sets a new password with the token of a reset link and logs the user out of every session.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Reset forgotten password",
+                "parameters": [
+                    {
+                        "description": "Token and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ConfirmPasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/installments": {
+            "post": {
+                "description": "Creates a new installment for a credit account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Create a new installment",
+                "parameters": [
+                    {
+                        "description": "Installment details",
+                        "name": "installment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateInstallmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.InstallmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/installments/{id}": {
+            "get": {
+                "description": "Retrieves an installment by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Get installment by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Installment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.InstallmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing installment by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Update an installment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Installment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated installment details",
+                        "name": "installment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateInstallmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.InstallmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an installment by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Installments"
+                ],
+                "summary": "Delete an installment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Installment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs": {
+            "get": {
+                "description": "Retrieves the scheduled jobs with their cron expression, state and next run.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Get all scheduled jobs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.ScheduledJobResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs/{name}/pause": {
+            "put": {
+                "description": "Stops the scheduled runs of a job on every replica. Manual triggers still work.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Pause a job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ScheduledJobResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs/{name}/resume": {
+            "put": {
+                "description": "Resumes the scheduled runs of a paused job.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Resume a job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ScheduledJobResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs/{name}/runs": {
+            "get": {
+                "description": "Retrieves the latest runs of a scheduled job, one per establishment and execution.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Get the run history of a job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of runs (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.JobRunResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs/{name}/trigger": {
+            "post": {
+                "description": "Runs a job right away for every establishment and returns the recorded runs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Trigger a job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.JobRunResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/late-fee-rules": {
+            "get": {
+                "description": "Retrieves the late fee rules of the caller's establishment, or every rule for platform admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFeeRules"
+                ],
+                "summary": "Get all late fee rules",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, name, days_overdue_min (default id)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PageResponse-response_LateFeeRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new late fee rule for an establishment (or globally).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFeeRules"
+                ],
+                "summary": "Create a late fee rule",
+                "parameters": [
+                    {
+                        "description": "Late fee rule details",
+                        "name": "lateFeeRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateLateFeeRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.LateFeeRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/late-fee-rules/{id}": {
+            "get": {
+                "description": "Retrieves a late fee rule by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFeeRules"
+                ],
+                "summary": "Get late fee rule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Late Fee Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LateFeeRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing late fee rule by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFeeRules"
+                ],
+                "summary": "Update a late fee rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Late Fee Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated late fee rule details",
+                        "name": "lateFeeRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateLateFeeRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LateFeeRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a late fee rule by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFeeRules"
+                ],
+                "summary": "Delete a late fee rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Late Fee Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/late-fees": {
+            "post": {
+                "description": "Creates a new late fee for a credit account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFees"
+                ],
+                "summary": "Create a late fee",
+                "parameters": [
+                    {
+                        "description": "Late fee details",
+                        "name": "lateFee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateLateFeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.LateFeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/late-fees/{id}": {
+            "get": {
+                "description": "Retrieves a late fee by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFees"
+                ],
+                "summary": "Get late fee by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Late Fee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LateFeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing late fee by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFees"
+                ],
+                "summary": "Update a late fee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Late Fee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated late fee details",
+                        "name": "lateFee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateLateFeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LateFeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a late fee by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LateFees"
+                ],
+                "summary": "Delete a late fee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Late Fee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/limit-changes/{id}/approve": {
+            "put": {
+                "description": "Applies a pending credit limit change and records it in the history of the credit account. A limit lower than the balance of the account is only applied with override=true.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CreditLimitChanges"
+                ],
+                "summary": "Approve a credit limit change",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Credit Limit Change ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Allow a limit lower than the current balance",
+                        "name": "override",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.CreditLimitChangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/limit-changes/{id}/reject": {
+            "put": {
+                "description": "Closes a pending credit limit change without applying it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CreditLimitChanges"
+                ],
+                "summary": "Reject a credit limit change",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Credit Limit Change ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.CreditLimitChangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/login": {
+            "post": {
+                "description": "Logs in with user credentials",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.AuthResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/logout": {
+            "post": {
+                "description": "Revokes the session of a refresh token. Access tokens already issued stay valid until they expire.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Log out",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {refreshToken}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid refresh token.",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/logout-all": {
+            "post": {
+                "description": "Revokes every session of the authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Log out everywhere",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {accessToken}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products": {
+            "get": {
+                "description": "Retrieves the products of the caller's establishment. Clients browse the products of every establishment, and platform admins see them all.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get all products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 200 (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Next cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort field, prefixed with - for descending order: id, name, price, stock, created_at (default id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product category",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price",
+                        "name": "max_amount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PageResponse-response_ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new product associated with an establishment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Product details",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{id}": {
+            "get": {
+                "description": "Retrieve a product by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get a product by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing product by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update an existing product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product details",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a product by ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/refresh": {
+            "post": {
+                "description": "Rotates a refresh token: returns a new access token and a new refresh token, and revokes the one presented. Reusing a rotated refresh token revokes the whole session.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {refreshToken}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.AuthResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired refresh token.",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/register": {
+            "post": {
+                "description": "Registers a new user with the provided data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reset-password": {
+            "post": {
+                "description": "Resets the password for the authenticated user, requiring the current password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {accessToken}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Reset password request",
+                        "name": "reset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/roles": {
+            "get": {
+                "description": "Lists the roles of the permission model.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Get all roles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.RoleResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions": {
+            "post": {
+                "description": "Create a new transaction for a credit account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Create Transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Transaction Data",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}": {
+            "get": {
+                "description": "Get a transaction by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get Transaction by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/allocations": {
+            "get": {
+                "description": "Get how a payment was split across fees, penalty interest, interest, principal and installments.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get Payment Allocations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.PaymentAllocationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/reverse": {
+            "post": {
+                "description": "Cancel a posted transaction with a REVERSAL that references it. Transactions are never edited or deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Reverse Transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries safe",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reversal Data",
+                        "name": "reversal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ReverseTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/roles": {
+            "put": {
+                "description": "Replaces the roles of a user and the establishment it works at, required for cashiers. The changes apply from the user's next access token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Assign roles to a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Roles",
+                        "name": "roles",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AssignRolesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entities.CreditRequestStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "APPROVED",
+                "REJECTED"
+            ],
+            "x-enum-varnames": [
+                "Pending",
+                "Approved",
+                "Rejected"
+            ]
+        },
+        "entities.ProductCategory": {
+            "type": "string",
+            "enum": [
+                "Grocery",
+                "FruitAndVeg",
+                "Meat",
+                "Poultry",
+                "Seafood",
+                "Bakery",
+                "Liquor",
+                "GeneralStore"
+            ],
+            "x-enum-varnames": [
+                "ProductCategoryGrocery",
+                "ProductCategoryFruitAndVeg",
+                "ProductCategoryMeat",
+                "ProductCategoryPoultry",
+                "ProductCategorySeafood",
+                "ProductCategoryBakery",
+                "ProductCategoryLiquor",
+                "ProductCategoryGeneralStore"
+            ]
+        },
+        "enums.AllocationComponent": {
+            "type": "string",
+            "enum": [
+                "FEES",
+                "PENALTY_INTEREST",
+                "INTEREST",
+                "PRINCIPAL"
+            ],
+            "x-enum-varnames": [
+                "FeesComponent",
+                "PenaltyInterestComponent",
+                "InterestComponent",
+                "PrincipalComponent"
+            ]
+        },
+        "enums.AmortizationMethod": {
+            "type": "string",
+            "enum": [
+                "FRENCH",
+                "GERMAN",
+                "BULLET"
+            ],
+            "x-enum-comments": {
+                "Bullet": "Interest only, principal at maturity",
+                "French": "Fixed payment",
+                "German": "Fixed principal"
+            },
+            "x-enum-varnames": [
+                "French",
+                "German",
+                "Bullet"
+            ]
+        },
+        "enums.CompoundingFrequency": {
+            "type": "string",
+            "enum": [
+                "DAILY",
+                "MONTHLY"
+            ],
+            "x-enum-varnames": [
+                "DailyCompounding",
+                "MonthlyCompounding"
+            ]
+        },
+        "enums.CreditDecision": {
+            "type": "string",
+            "enum": [
+                "AUTO_APPROVED",
+                "AUTO_REJECTED",
+                "MANUAL_REVIEW"
+            ],
+            "x-enum-comments": {
+                "ManualReview": "Left PENDING for an establishment admin"
+            },
+            "x-enum-varnames": [
+                "AutoApproved",
+                "AutoRejected",
+                "ManualReview"
+            ]
+        },
+        "enums.CreditType": {
+            "type": "string",
+            "enum": [
+                "SHORT_TERM",
+                "LONG_TERM"
+            ],
+            "x-enum-varnames": [
+                "ShortTerm",
+                "LongTerm"
+            ]
+        },
+        "enums.DayCountConvention": {
+            "type": "string",
+            "enum": [
+                "ACT_360",
+                "ACT_365"
+            ],
+            "x-enum-varnames": [
+                "Actual360",
+                "Actual365"
+            ]
+        },
+        "enums.FeeType": {
+            "type": "string",
+            "enum": [
+                "PERCENTAGE",
+                "FIXED_AMOUNT"
+            ],
+            "x-enum-varnames": [
+                "Percentage",
+                "FixedAmount"
+            ]
+        },
+        "enums.GraceType": {
+            "type": "string",
+            "enum": [
+                "TOTAL",
+                "PARTIAL"
+            ],
+            "x-enum-comments": {
+                "PartialGrace": "Interest-only payments, principal is deferred",
+                "TotalGrace": "No payments, interest is capitalized"
+            },
+            "x-enum-varnames": [
+                "TotalGrace",
+                "PartialGrace"
+            ]
+        },
+        "enums.InstallmentStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "PAID",
+                "PARTIALLY_PAID",
+                "OVERDUE"
+            ],
+            "x-enum-varnames": [
+                "Pending",
+                "Paid",
+                "PartiallyPaid",
+                "Overdue"
+            ]
+        },
+        "enums.InterestType": {
+            "type": "string",
+            "enum": [
+                "NOMINAL",
+                "EFFECTIVE"
+            ],
+            "x-enum-varnames": [
+                "Nominal",
+                "Effective"
+            ]
+        },
+        "enums.JobRunStatus": {
+            "type": "string",
+            "enum": [
+                "RUNNING",
+                "SUCCEEDED",
+                "FAILED"
+            ],
+            "x-enum-varnames": [
+                "JobRunning",
+                "JobSucceeded",
+                "JobFailed"
+            ]
+        },
+        "enums.JobTrigger": {
+            "type": "string",
+            "enum": [
+                "SCHEDULED",
+                "MANUAL"
+            ],
+            "x-enum-varnames": [
+                "ScheduledTrigger",
+                "ManualTrigger"
+            ]
+        },
+        "enums.LedgerAccountType": {
+            "type": "string",
+            "enum": [
+                "ASSET",
+                "LIABILITY",
+                "EQUITY",
+                "REVENUE",
+                "EXPENSE"
+            ],
+            "x-enum-varnames": [
+                "AssetAccount",
+                "LiabilityAccount",
+                "EquityAccount",
+                "RevenueAccount",
+                "ExpenseAccount"
+            ]
+        },
+        "enums.PrepaymentMode": {
+            "type": "string",
+            "enum": [
+                "REDUCE_TERM",
+                "REDUCE_INSTALLMENT"
+            ],
+            "x-enum-comments": {
+                "ReduceInstallment": "Keep the term, pay less per installment",
+                "ReduceTerm": "Keep the installment, pay off sooner"
+            },
+            "x-enum-varnames": [
+                "ReduceTerm",
+                "ReduceInstallment"
+            ]
+        },
+        "enums.ReasonCode": {
+            "type": "string",
+            "enum": [
+                "DUPLICATE",
+                "WRONG_AMOUNT",
+                "WRONG_ACCOUNT",
+                "DISPUTE",
+                "FEE_WAIVER",
+                "RETURNED_PAYMENT",
+                "RECONCILIATION",
+                "OTHER"
+            ],
+            "x-enum-comments": {
+                "DisputeReason": "The client disputed the movement",
+                "DuplicateReason": "The same movement was posted twice",
+                "FeeWaiverReason": "A fee or interest charge was waived",
+                "ReconciliationReason": "Posted by the reconciliation tool, never by users",
+                "ReturnedPaymentReason": "The payment bounced or was charged back",
+                "WrongAccountReason": "The movement belongs to another credit account",
+                "WrongAmountReason": "The movement was posted with an incorrect amount"
+            },
+            "x-enum-varnames": [
+                "DuplicateReason",
+                "WrongAmountReason",
+                "WrongAccountReason",
+                "DisputeReason",
+                "FeeWaiverReason",
+                "ReturnedPaymentReason",
+                "ReconciliationReason",
+                "OtherReason"
+            ]
+        },
+        "enums.RecipientType": {
+            "type": "string",
+            "enum": [
+                "CLIENT",
+                "ESTABLISHMENT",
+                "ADMIN"
+            ],
+            "x-enum-varnames": [
+                "RolClient",
+                "RolEstablishment",
+                "RolAdmin"
+            ]
+        },
+        "enums.RoleName": {
+            "type": "string",
+            "enum": [
+                "platform_admin",
+                "establishment_admin",
+                "cashier",
+                "client"
+            ],
+            "x-enum-comments": {
+                "CashierRole": "Registers purchases and payments at an establishment",
+                "ClientRole": "Buys on credit, default role of new users",
+                "EstablishmentAdminRole": "Manages an establishment, its clients and its credit",
+                "PlatformAdminRole": "Operates the platform, passes every role check"
+            },
+            "x-enum-varnames": [
+                "PlatformAdminRole",
+                "EstablishmentAdminRole",
+                "CashierRole",
+                "ClientRole"
+            ]
+        },
+        "enums.TransactionType": {
+            "type": "string",
+            "enum": [
+                "PURCHASE",
+                "PAYMENT",
+                "DISBURSEMENT",
+                "INTEREST_ACCRUAL",
+                "INTEREST_CAPITALIZED",
+                "PENALTY_INTEREST",
+                "LATE_FEE_APPLIED",
+                "CREDIT_LIMIT_INCREASE",
+                "CREDIT_LIMIT_DECREASE",
+                "EARLY_PAYMENT",
+                "ACCOUNT_BLOCKED",
+                "ACCOUNT_UNBLOCKED",
+                "REVERSAL",
+                "ADJUSTMENT",
+                "WRITE_OFF"
+            ],
+            "x-enum-comments": {
+                "Adjustment": "Manual correction of the balance, positive or negative",
+                "Disbursement": "Principal of a LONG_TERM loan handed to the client",
+                "InterestCapitalized": "Interest added to principal during a total grace period",
+                "PenaltyInterest": "Interest charged on overdue installments",
+                "Reversal": "Cancels a posted transaction, see Transaction.ReversalOfID",
+                "WriteOff": "Uncollectible balance charged to bad debt"
+            },
+            "x-enum-varnames": [
+                "Purchase",
+                "Payment",
+                "Disbursement",
+                "InterestAccrual",
+                "InterestCapitalized",
+                "PenaltyInterest",
+                "LateFeeApplied",
+                "CreditLimitIncrease",
+                "CreditLimitDecrease",
+                "EarlyPayment",
+                "AccountBlocked",
+                "AccountUnblocked",
+                "Reversal",
+                "Adjustment",
+                "WriteOff"
+            ]
+        },
+        "request.AssignRolesRequest": {
+            "type": "object",
+            "required": [
+                "roles"
+            ],
+            "properties": {
+                "establishment_id": {
+                    "description": "Required for cashiers",
+                    "type": "integer"
+                },
+                "roles": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/enums.RoleName"
+                    }
+                }
+            }
+        },
+        "request.ConfirmPasswordResetRequest": {
+            "type": "object",
+            "required": [
+                "new_password",
+                "token"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.CreateAdjustmentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "description",
+                "reason_code"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Positive increases the balance, negative decreases it",
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "reason_code": {
+                    "enum": [
+                        "DUPLICATE",
+                        "WRONG_AMOUNT",
+                        "WRONG_ACCOUNT",
+                        "DISPUTE",
+                        "FEE_WAIVER",
+                        "RETURNED_PAYMENT",
+                        "OTHER"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.ReasonCode"
+                        }
+                    ]
+                }
+            }
+        },
+        "request.CreateAdminRequest": {
+            "type": "object",
+            "required": [
+                "establishment_id",
+                "user_id"
+            ],
+            "properties": {
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.CreateClientRequest": {
+            "type": "object",
+            "required": [
+                "credit_limit",
+                "email",
+                "phone",
+                "user_id"
+            ],
+            "properties": {
+                "credit_limit": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "email": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.CreateCreditAccountRequest": {
+            "type": "object",
+            "required": [
+                "client_id",
+                "credit_limit",
+                "credit_type",
+                "establishment_id",
+                "interest_rate",
+                "interest_type",
+                "monthly_due_date"
+            ],
+            "properties": {
+                "amortization_method": {
+                    "description": "Optional, defaults to FRENCH",
+                    "enum": [
+                        "FRENCH",
+                        "GERMAN",
+                        "BULLET"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.AmortizationMethod"
+                        }
+                    ]
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "compounding": {
+                    "description": "Optional, defaults to MONTHLY",
+                    "enum": [
+                        "DAILY",
+                        "MONTHLY"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.CompoundingFrequency"
+                        }
+                    ]
+                },
+                "credit_limit": {
+                    "type": "number"
+                },
+                "credit_type": {
+                    "$ref": "#/definitions/enums.CreditType"
+                },
+                "day_count": {
+                    "description": "Optional, defaults to ACT_360",
+                    "enum": [
+                        "ACT_360",
+                        "ACT_365"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.DayCountConvention"
+                        }
+                    ]
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "grace_period": {
+                    "description": "Optional",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "grace_type": {
+                    "description": "Optional, defaults to PARTIAL",
+                    "enum": [
+                        "TOTAL",
+                        "PARTIAL"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.GraceType"
+                        }
+                    ]
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "interest_type": {
+                    "$ref": "#/definitions/enums.InterestType"
+                },
+                "late_fee_rule_id": {
+                    "description": "Optional",
+                    "type": "integer"
+                },
+                "monthly_due_date": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1
+                },
+                "penalty_interest_rate": {
+                    "description": "Optional, charged on overdue installments of LONG_TERM accounts",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "term": {
+                    "description": "Required for LONG_TERM, in months",
+                    "type": "integer",
+                    "maximum": 360,
+                    "minimum": 1
+                }
+            }
+        },
+        "request.CreateCreditLimitChangeRequest": {
+            "type": "object",
+            "required": [
+                "new_limit"
+            ],
+            "properties": {
+                "new_limit": {
+                    "type": "number"
+                },
+                "reason": {
+                    "description": "Optional",
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "request.CreateCreditRequest": {
+            "type": "object",
+            "required": [
+                "client_id",
+                "credit_type",
+                "establishment_id",
+                "interest_rate",
+                "interest_type",
+                "monthly_due_date",
+                "requested_credit_limit"
+            ],
+            "properties": {
+                "amortization_method": {
+                    "description": "Optional, defaults to FRENCH",
+                    "enum": [
+                        "FRENCH",
+                        "GERMAN",
+                        "BULLET"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.AmortizationMethod"
+                        }
+                    ]
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "compounding": {
+                    "description": "Optional, defaults to MONTHLY",
+                    "enum": [
+                        "DAILY",
+                        "MONTHLY"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.CompoundingFrequency"
+                        }
+                    ]
+                },
+                "credit_type": {
+                    "$ref": "#/definitions/enums.CreditType"
+                },
+                "day_count": {
+                    "description": "Optional, defaults to ACT_360",
+                    "enum": [
+                        "ACT_360",
+                        "ACT_365"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.DayCountConvention"
+                        }
+                    ]
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "grace_period": {
+                    "description": "Optional",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "grace_type": {
+                    "description": "Optional, defaults to PARTIAL",
+                    "enum": [
+                        "TOTAL",
+                        "PARTIAL"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.GraceType"
+                        }
+                    ]
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "interest_type": {
+                    "$ref": "#/definitions/enums.InterestType"
+                },
+                "monthly_due_date": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1
+                },
+                "requested_credit_limit": {
+                    "type": "number"
+                },
+                "term": {
+                    "description": "Required for LONG_TERM, in months",
+                    "type": "integer",
+                    "maximum": 360,
+                    "minimum": 1
+                }
+            }
+        },
+        "request.CreateEstablishmentRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "admin_id",
+                "name",
+                "phone",
+                "ruc"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "admin_id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "ruc": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.CreateInstallmentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "credit_account_id",
+                "due_date",
+                "status"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.InstallmentStatus"
+                }
+            }
+        },
+        "request.CreateLateFeeRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "credit_account_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "credit_account_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.CreateLateFeeRuleRequest": {
+            "type": "object",
+            "required": [
+                "days_overdue_max",
+                "days_overdue_min",
+                "fee_type",
+                "fee_value",
+                "name"
+            ],
+            "properties": {
+                "days_overdue_max": {
+                    "type": "integer"
+                },
+                "days_overdue_min": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "establishment_id": {
+                    "description": "Optional, if rule is for specific establishment",
+                    "type": "integer"
+                },
+                "fee_type": {
+                    "$ref": "#/definitions/enums.FeeType"
+                },
+                "fee_value": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.CreateProductRequest": {
+            "type": "object",
+            "required": [
+                "category",
+                "description",
+                "establishment_id",
+                "name",
+                "price",
+                "stock"
+            ],
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/entities.ProductCategory"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "stock": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "request.CreatePurchaseRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Optional, defaults to the list of items",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/request.PurchaseItemRequest"
+                    }
+                }
+            }
+        },
+        "request.CreateTransactionRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "credit_account_id",
+                "recipient_id",
+                "recipient_type",
+                "transaction_type"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "Optional",
+                    "type": "string"
+                },
+                "recipient_id": {
+                    "type": "integer"
+                },
+                "recipient_type": {
+                    "$ref": "#/definitions/enums.RecipientType"
+                },
+                "transaction_type": {
+                    "$ref": "#/definitions/enums.TransactionType"
+                }
+            }
+        },
+        "request.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "role": {
+                    "description": "Puedes usar enum si lo prefieres",
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "client",
+                        "admin"
+                    ]
+                }
+            }
+        },
+        "request.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PrepaymentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "mode"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "description": {
+                    "description": "Optional",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "mode": {
+                    "description": "Ignored when the account is paid off",
+                    "enum": [
+                        "REDUCE_TERM",
+                        "REDUCE_INSTALLMENT"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.PrepaymentMode"
+                        }
+                    ]
+                }
+            }
+        },
+        "request.PurchaseItemRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "description": "Optional, overrides the catalog price",
+                    "type": "number"
+                }
+            }
+        },
+        "request.RegenerateScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "amortization_method": {
+                    "description": "Optional, keeps the current method",
+                    "enum": [
+                        "FRENCH",
+                        "GERMAN",
+                        "BULLET"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.AmortizationMethod"
+                        }
+                    ]
+                },
+                "term": {
+                    "description": "Optional, keeps the current term",
+                    "type": "integer",
+                    "maximum": 360,
+                    "minimum": 1
+                }
+            }
+        },
+        "request.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "request.ReverseTransactionRequest": {
+            "type": "object",
+            "required": [
+                "reason_code"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Optional",
+                    "type": "string"
+                },
+                "reason_code": {
+                    "enum": [
+                        "DUPLICATE",
+                        "WRONG_AMOUNT",
+                        "WRONG_ACCOUNT",
+                        "DISPUTE",
+                        "FEE_WAIVER",
+                        "RETURNED_PAYMENT",
+                        "OTHER"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.ReasonCode"
+                        }
+                    ]
+                }
+            }
+        },
+        "request.UpdateAdminRequest": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "request.UpdateClientRequest": {
+            "type": "object",
+            "required": [
+                "credit_limit",
+                "email",
+                "phone"
+            ],
+            "properties": {
+                "credit_limit": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "email": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.UpdateCreditAccountRequest": {
+            "type": "object",
+            "properties": {
+                "amortization_method": {
+                    "enum": [
+                        "FRENCH",
+                        "GERMAN",
+                        "BULLET"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.AmortizationMethod"
+                        }
+                    ]
+                },
+                "compounding": {
+                    "enum": [
+                        "DAILY",
+                        "MONTHLY"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.CompoundingFrequency"
+                        }
+                    ]
+                },
+                "credit_limit": {
+                    "type": "number"
+                },
+                "credit_type": {
+                    "$ref": "#/definitions/enums.CreditType"
+                },
+                "current_balance": {
+                    "description": "Added CurrentBalance field",
+                    "type": "number"
+                },
+                "day_count": {
+                    "enum": [
+                        "ACT_360",
+                        "ACT_365"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.DayCountConvention"
+                        }
+                    ]
+                },
+                "grace_period": {
+                    "description": "Left unchanged when omitted",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "grace_type": {
+                    "enum": [
+                        "TOTAL",
+                        "PARTIAL"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.GraceType"
+                        }
+                    ]
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "interest_type": {
+                    "$ref": "#/definitions/enums.InterestType"
+                },
+                "is_blocked": {
+                    "description": "Left unchanged when omitted",
+                    "type": "boolean"
+                },
+                "late_fee_rule_id": {
+                    "description": "Optional",
+                    "type": "integer"
+                },
+                "monthly_due_date": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1
+                },
+                "penalty_interest_rate": {
+                    "description": "Left unchanged when omitted",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "term": {
+                    "type": "integer",
+                    "maximum": 360,
+                    "minimum": 1
+                }
+            }
+        },
+        "request.UpdateCreditPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "auto_approve_min_score": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "auto_reject_below_score": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "limit_review_percent": {
+                    "description": "Optional, 0 disables automatic limit reviews",
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "max_auto_approve_limit": {
+                    "description": "Optional, 0 for no cap",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "max_grace_period": {
+                    "description": "Optional, 0 leaves every request with a grace period for manual review",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max_term": {
+                    "description": "Optional, 0 leaves every LONG_TERM request for manual review",
+                    "type": "integer",
+                    "maximum": 360,
+                    "minimum": 0
+                },
+                "min_interest_rate": {
+                    "description": "Lowest TEA approved automatically, as a percentage",
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "request.UpdateDelinquencyPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "block_after_days_overdue": {
+                    "description": "Optional, 0 never blocks",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "unblock_when_cleared": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "request.UpdateEstablishmentRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "name",
+                "phone",
+                "ruc"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payment_allocation_order": {
+                    "description": "Optional, e.g. [\"FEES\",\"INTEREST\",\"PRINCIPAL\"]",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enums.AllocationComponent"
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "ruc": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.UpdateInstallmentRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.InstallmentStatus"
+                }
+            }
+        },
+        "request.UpdateLateFeeRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                }
+            }
+        },
+        "request.UpdateLateFeeRuleRequest": {
+            "type": "object",
+            "properties": {
+                "days_overdue_max": {
+                    "type": "integer"
+                },
+                "days_overdue_min": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "fee_type": {
+                    "$ref": "#/definitions/enums.FeeType"
+                },
+                "fee_value": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.UpdateProductRequest": {
+            "type": "object",
+            "required": [
+                "category",
+                "description",
+                "name",
+                "price",
+                "stock"
+            ],
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/entities.ProductCategory"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "stock": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "request.WriteOffRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "reason_code"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "reason_code": {
+                    "enum": [
+                        "DUPLICATE",
+                        "WRONG_AMOUNT",
+                        "WRONG_ACCOUNT",
+                        "DISPUTE",
+                        "FEE_WAIVER",
+                        "RETURNED_PAYMENT",
+                        "OTHER"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/enums.ReasonCode"
+                        }
+                    ]
+                }
+            }
+        },
+        "response.AccountReconciliationResponse": {
+            "type": "object",
+            "properties": {
+                "adjusted": {
+                    "type": "boolean"
+                },
+                "adjustment_id": {
+                    "type": "integer"
+                },
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "current_balance": {
+                    "type": "number"
+                },
+                "difference": {
+                    "description": "History balance minus current balance",
+                    "type": "number"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "history_balance": {
+                    "type": "number"
+                },
+                "ledger_balance": {
+                    "type": "number"
+                },
+                "ledger_difference": {
+                    "description": "Ledger balance minus history balance",
+                    "type": "number"
+                },
+                "transaction_balance": {
+                    "type": "number"
+                },
+                "transaction_difference": {
+                    "description": "Transaction balance minus history balance",
+                    "type": "number"
+                }
+            }
+        },
+        "response.AdminDebtSummary": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "integer"
+                },
+                "client_name": {
+                    "type": "string"
+                },
+                "credit_type": {
+                    "type": "string"
+                },
+                "current_balance": {
+                    "type": "number"
+                },
+                "due_date": {
+                    "description": "For short-term or next installment",
+                    "type": "string"
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "number_of_installments": {
+                    "description": "Only for long-term",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.AdminResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "establishment": {
+                    "$ref": "#/definitions/response.EstablishmentResponse"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor_user_id": {
+                    "type": "integer"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "type": "string"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "hash": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "prev_hash": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.AuditVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "broken_at": {
+                    "description": "First entry whose hash does not match, nil when the chain is intact",
+                    "type": "integer"
+                },
+                "checked": {
+                    "description": "Entries verified, up to the first broken one",
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "response.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ClientResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_limit": {
+                    "type": "number"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.CreditAccountHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Change of the balance",
+                    "type": "number"
+                },
+                "balance": {
+                    "description": "Running balance after the entry",
+                    "type": "number"
+                },
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lines": {
+                    "description": "Items of an itemized purchase",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PurchaseLineResponse"
+                    }
+                },
+                "new_limit": {
+                    "description": "Set on credit limit changes",
+                    "type": "number"
+                },
+                "old_limit": {
+                    "description": "Set on credit limit changes",
+                    "type": "number"
+                },
+                "transaction_date": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "integer"
+                },
+                "transaction_type": {
+                    "$ref": "#/definitions/enums.TransactionType"
+                }
+            }
+        },
+        "response.CreditAccountResponse": {
+            "type": "object",
+            "properties": {
+                "amortization_method": {
+                    "$ref": "#/definitions/enums.AmortizationMethod"
+                },
+                "blocked_by_policy": {
+                    "type": "boolean"
+                },
+                "client": {
+                    "$ref": "#/definitions/response.ClientResponse"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "compounding": {
+                    "$ref": "#/definitions/enums.CompoundingFrequency"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_limit": {
+                    "type": "number"
+                },
+                "credit_type": {
+                    "$ref": "#/definitions/enums.CreditType"
+                },
+                "current_balance": {
+                    "type": "number"
+                },
+                "day_count": {
+                    "$ref": "#/definitions/enums.DayCountConvention"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "fees_balance": {
+                    "type": "number"
+                },
+                "grace_period": {
+                    "type": "integer"
+                },
+                "grace_type": {
+                    "$ref": "#/definitions/enums.GraceType"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interest_balance": {
+                    "type": "number"
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "interest_type": {
+                    "$ref": "#/definitions/enums.InterestType"
+                },
+                "is_blocked": {
+                    "type": "boolean"
+                },
+                "last_interest_accrual_date": {
+                    "type": "string"
+                },
+                "last_penalty_accrual_date": {
+                    "type": "string"
+                },
+                "late_fee_rule": {
+                    "$ref": "#/definitions/response.LateFeeRuleResponse"
+                },
+                "late_fee_rule_id": {
+                    "type": "integer"
+                },
+                "monthly_due_date": {
+                    "type": "integer"
+                },
+                "penalty_interest_balance": {
+                    "type": "number"
+                },
+                "penalty_interest_rate": {
+                    "type": "number"
+                },
+                "term": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CreditLimitChangeResponse": {
+            "type": "object",
+            "properties": {
+                "automatic": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "decided_at": {
+                    "type": "string"
+                },
+                "decided_by_user_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_limit": {
+                    "type": "number"
+                },
+                "old_limit": {
+                    "type": "number"
+                },
+                "override": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requested_by_user_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.CreditRequestStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CreditPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "auto_approve_min_score": {
+                    "type": "integer"
+                },
+                "auto_reject_below_score": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "limit_review_percent": {
+                    "type": "number"
+                },
+                "max_auto_approve_limit": {
+                    "type": "number"
+                },
+                "max_grace_period": {
+                    "type": "integer"
+                },
+                "max_term": {
+                    "type": "integer"
+                },
+                "min_interest_rate": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CreditRequestResponse": {
+            "type": "object",
+            "properties": {
+                "amortization_method": {
+                    "$ref": "#/definitions/enums.AmortizationMethod"
+                },
+                "approved_at": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "compounding": {
+                    "$ref": "#/definitions/enums.CompoundingFrequency"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_type": {
+                    "$ref": "#/definitions/enums.CreditType"
+                },
+                "day_count": {
+                    "$ref": "#/definitions/enums.DayCountConvention"
+                },
+                "decision": {
+                    "$ref": "#/definitions/enums.CreditDecision"
+                },
+                "decision_reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "grace_period": {
+                    "type": "integer"
+                },
+                "grace_type": {
+                    "$ref": "#/definitions/enums.GraceType"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "interest_type": {
+                    "$ref": "#/definitions/enums.InterestType"
+                },
+                "monthly_due_date": {
+                    "type": "integer"
+                },
+                "rejected_at": {
+                    "type": "string"
+                },
+                "requested_credit_limit": {
+                    "type": "number"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.CreditRequestStatus"
+                },
+                "term": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
         },
-        "enums.FeeType": {
-            "type": "string",
-            "enum": [
-                "PERCENTAGE",
-                "FIXED_AMOUNT"
-            ],
-            "x-enum-varnames": [
-                "Percentage",
-                "FixedAmount"
-            ]
+        "response.DelinquencyPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "block_after_days_overdue": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "unblock_when_cleared": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
         },
-        "enums.InstallmentStatus": {
-            "type": "string",
-            "enum": [
-                "PENDING",
-                "PAID",
-                "OVERDUE"
-            ],
-            "x-enum-varnames": [
-                "Pending",
-                "Paid",
-                "Overdue"
-            ]
+        "response.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
         },
-        "enums.InterestType": {
-            "type": "string",
-            "enum": [
-                "NOMINAL",
-                "EFFECTIVE"
-            ],
-            "x-enum-varnames": [
-                "Nominal",
-                "Effective"
-            ]
+        "response.EstablishmentResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "admin": {
+                    "$ref": "#/definitions/response.AdminResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payment_allocation_order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enums.AllocationComponent"
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProductResponse"
+                    }
+                },
+                "ruc": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
         },
-        "enums.RecipientType": {
-            "type": "string",
-            "enum": [
-                "CLIENT",
-                "ESTABLISHMENT",
-                "ADMIN"
-            ],
-            "x-enum-varnames": [
-                "RolClient",
-                "RolEstablishment",
-                "RolAdmin"
-            ]
+        "response.InstallmentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "balance": {
+                    "type": "number"
+                },
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "interest_paid": {
+                    "type": "number"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "principal_paid": {
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.InstallmentStatus"
+                }
+            }
         },
-        "enums.TransactionType": {
-            "type": "string",
-            "enum": [
-                "PURCHASE",
-                "PAYMENT",
-                "INTEREST_ACCRUAL",
-                "LATE_FEE_APPLIED",
-                "CREDIT_LIMIT_INCREASE",
-                "CREDIT_LIMIT_DECREASE",
-                "EARLY_PAYMENT",
-                "ACCOUNT_BLOCKED",
-                "ACCOUNT_UNBLOCKED"
-            ],
-            "x-enum-varnames": [
-                "Purchase",
-                "Payment",
-                "InterestAccrual",
-                "LateFeeApplied",
-                "CreditLimitIncrease",
-                "CreditLimitDecrease",
-                "EarlyPayment",
-                "AccountBlocked",
-                "AccountUnblocked"
-            ]
+        "response.JobRunResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "establishment_id": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "scheduled_job_id": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.JobRunStatus"
+                },
+                "trigger": {
+                    "$ref": "#/definitions/enums.JobTrigger"
+                }
+            }
         },
-        "request.CreateAdminRequest": {
+        "response.JournalEntryResponse": {
             "type": "object",
-            "required": [
-                "establishment_id",
-                "user_id"
-            ],
             "properties": {
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "entry_date": {
+                    "type": "string"
+                },
                 "establishment_id": {
                     "type": "integer"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "id": {
+                    "type": "integer"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.JournalLineResponse"
+                    }
+                },
+                "transaction_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.JournalLineResponse": {
+            "type": "object",
+            "properties": {
+                "account_code": {
+                    "type": "string"
+                },
+                "account_name": {
+                    "type": "string"
+                },
+                "credit": {
+                    "type": "number"
                 },
-                "user_id": {
-                    "type": "integer"
+                "debit": {
+                    "type": "number"
                 }
             }
         },
-        "request.CreateClientRequest": {
+        "response.LateFeeResponse": {
             "type": "object",
-            "required": [
-                "credit_limit",
-                "email",
-                "phone",
-                "user_id"
-            ],
             "properties": {
-                "credit_limit": {
-                    "type": "number",
-                    "minimum": 0
+                "amount": {
+                    "type": "number"
                 },
-                "email": {
+                "applied_date": {
                     "type": "string"
                 },
-                "is_active": {
-                    "type": "boolean"
-                },
-                "phone": {
-                    "type": "string"
+                "credit_account_id": {
+                    "type": "integer"
                 },
-                "user_id": {
+                "id": {
                     "type": "integer"
                 }
             }
         },
-        "request.CreateCreditAccountRequest": {
+        "response.LateFeeRuleResponse": {
             "type": "object",
-            "required": [
-                "client_id",
-                "credit_limit",
-                "credit_type",
-                "establishment_id",
-                "interest_rate",
-                "interest_type",
-                "monthly_due_date"
-            ],
             "properties": {
-                "client_id": {
+                "days_overdue_max": {
                     "type": "integer"
                 },
-                "credit_limit": {
-                    "type": "number"
-                },
-                "credit_type": {
-                    "$ref": "#/definitions/enums.CreditType"
+                "days_overdue_min": {
+                    "type": "integer"
                 },
                 "establishment_id": {
                     "type": "integer"
                 },
-                "grace_period": {
-                    "description": "Optional",
-                    "type": "integer",
-                    "minimum": 0
+                "fee_type": {
+                    "$ref": "#/definitions/enums.FeeType"
                 },
-                "interest_rate": {
+                "fee_value": {
                     "type": "number"
                 },
-                "interest_type": {
-                    "$ref": "#/definitions/enums.InterestType"
-                },
-                "late_fee_rule_id": {
-                    "description": "Optional",
+                "id": {
                     "type": "integer"
                 },
-                "monthly_due_date": {
-                    "type": "integer",
-                    "maximum": 31,
-                    "minimum": 1
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "request.CreateCreditRequest": {
+        "response.LedgerAccountResponse": {
             "type": "object",
-            "required": [
-                "client_id",
-                "credit_type",
-                "establishment_id",
-                "interest_rate",
-                "interest_type",
-                "monthly_due_date",
-                "requested_credit_limit"
-            ],
             "properties": {
-                "client_id": {
+                "code": {
+                    "type": "string"
+                },
+                "establishment_id": {
                     "type": "integer"
                 },
-                "credit_type": {
-                    "$ref": "#/definitions/enums.CreditType"
+                "id": {
+                    "type": "integer"
                 },
-                "establishment_id": {
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/enums.LedgerAccountType"
+                }
+            }
+        },
+        "response.LedgerBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "balanced": {
+                    "type": "boolean"
+                },
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LedgerComponentResponse"
+                    }
+                },
+                "credit_account_id": {
                     "type": "integer"
                 },
-                "grace_period": {
-                    "description": "Optional",
-                    "type": "integer",
-                    "minimum": 0
+                "current_balance": {
+                    "type": "number"
                 },
-                "interest_rate": {
+                "difference": {
                     "type": "number"
                 },
-                "interest_type": {
-                    "$ref": "#/definitions/enums.InterestType"
+                "ledger_balance": {
+                    "type": "number"
+                }
+            }
+        },
+        "response.LedgerComponentResponse": {
+            "type": "object",
+            "properties": {
+                "account_balance": {
+                    "type": "number"
                 },
-                "monthly_due_date": {
-                    "type": "integer",
-                    "maximum": 31,
-                    "minimum": 1
+                "component": {
+                    "$ref": "#/definitions/enums.AllocationComponent"
                 },
-                "requested_credit_limit": {
+                "difference": {
+                    "type": "number"
+                },
+                "ledger_balance": {
                     "type": "number"
                 }
             }
         },
-        "request.CreateEstablishmentRequest": {
+        "response.PageResponse-response_AdminResponse": {
             "type": "object",
-            "required": [
-                "address",
-                "admin_id",
-                "name",
-                "phone",
-                "ruc"
-            ],
             "properties": {
-                "address": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AdminResponse"
+                    }
                 },
-                "admin_id": {
+                "limit": {
                     "type": "integer"
                 },
-                "is_active": {
-                    "type": "boolean"
-                },
-                "name": {
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "phone": {
-                    "type": "string"
+                "offset": {
+                    "type": "integer"
                 },
-                "ruc": {
-                    "type": "string"
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.CreateInstallmentRequest": {
+        "response.PageResponse-response_AuditLogResponse": {
             "type": "object",
-            "required": [
-                "amount",
-                "credit_account_id",
-                "due_date",
-                "status"
-            ],
             "properties": {
-                "amount": {
-                    "type": "number"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AuditLogResponse"
+                    }
                 },
-                "credit_account_id": {
+                "limit": {
                     "type": "integer"
                 },
-                "due_date": {
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/enums.InstallmentStatus"
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.CreateLateFeeRequest": {
+        "response.PageResponse-response_ClientResponse": {
             "type": "object",
-            "required": [
-                "amount",
-                "credit_account_id"
-            ],
             "properties": {
-                "amount": {
-                    "type": "number"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ClientResponse"
+                    }
                 },
-                "credit_account_id": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "Empty on the last page",
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Items matching the filters, on every page",
                     "type": "integer"
                 }
             }
         },
-        "request.CreateLateFeeRuleRequest": {
+        "response.PageResponse-response_CreditAccountResponse": {
             "type": "object",
-            "required": [
-                "days_overdue_max",
-                "days_overdue_min",
-                "fee_type",
-                "fee_value",
-                "name"
-            ],
             "properties": {
-                "days_overdue_max": {
-                    "type": "integer"
-                },
-                "days_overdue_min": {
-                    "type": "integer",
-                    "minimum": 1
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CreditAccountResponse"
+                    }
                 },
-                "establishment_id": {
-                    "description": "Optional, if rule is for specific establishment",
+                "limit": {
                     "type": "integer"
                 },
-                "fee_type": {
-                    "$ref": "#/definitions/enums.FeeType"
+                "next_cursor": {
+                    "description": "Empty on the last page",
+                    "type": "string"
                 },
-                "fee_value": {
-                    "type": "number"
+                "offset": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.CreateProductRequest": {
+        "response.PageResponse-response_CreditLimitChangeResponse": {
             "type": "object",
-            "required": [
-                "category",
-                "description",
-                "establishment_id",
-                "name",
-                "price",
-                "stock"
-            ],
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/entities.ProductCategory"
-                },
-                "description": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CreditLimitChangeResponse"
+                    }
                 },
-                "establishment_id": {
+                "limit": {
                     "type": "integer"
                 },
-                "is_active": {
-                    "type": "boolean"
-                },
-                "name": {
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "offset": {
+                    "type": "integer"
                 },
-                "stock": {
-                    "type": "integer",
-                    "minimum": 0
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.CreateTransactionRequest": {
+        "response.PageResponse-response_CreditRequestResponse": {
             "type": "object",
-            "required": [
-                "amount",
-                "credit_account_id",
-                "recipient_id",
-                "recipient_type",
-                "transaction_type"
-            ],
             "properties": {
-                "amount": {
-                    "type": "number"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CreditRequestResponse"
+                    }
                 },
-                "credit_account_id": {
+                "limit": {
                     "type": "integer"
                 },
-                "description": {
-                    "description": "Optional",
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "recipient_id": {
+                "offset": {
                     "type": "integer"
                 },
-                "recipient_type": {
-                    "$ref": "#/definitions/enums.RecipientType"
-                },
-                "transaction_type": {
-                    "$ref": "#/definitions/enums.TransactionType"
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.CreateUserRequest": {
+        "response.PageResponse-response_InstallmentResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password",
-                "role"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.InstallmentResponse"
+                    }
                 },
-                "name": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "offset": {
+                    "type": "integer"
                 },
-                "role": {
-                    "description": "Puedes usar enum si lo prefieres",
-                    "type": "string",
-                    "enum": [
-                        "user",
-                        "client",
-                        "admin"
-                    ]
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.LoginRequest": {
+        "response.PageResponse-response_JournalEntryResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.JournalEntryResponse"
+                    }
                 },
-                "password": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.ResetPasswordRequest": {
+        "response.PageResponse-response_LateFeeResponse": {
             "type": "object",
-            "required": [
-                "current_password",
-                "new_password"
-            ],
             "properties": {
-                "current_password": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LateFeeResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "new_password": {
-                    "type": "string",
-                    "minLength": 6
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateAdminRequest": {
+        "response.PageResponse-response_LateFeeRuleResponse": {
             "type": "object",
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LateFeeRuleResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "Empty on the last page",
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateClientRequest": {
+        "response.PageResponse-response_ProductResponse": {
             "type": "object",
-            "required": [
-                "credit_limit",
-                "email",
-                "phone"
-            ],
             "properties": {
-                "credit_limit": {
-                    "type": "number",
-                    "minimum": 0
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProductResponse"
+                    }
                 },
-                "email": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "offset": {
+                    "type": "integer"
                 },
-                "phone": {
-                    "type": "string"
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateCreditAccountRequest": {
+        "response.PageResponse-response_StatementResponse": {
             "type": "object",
             "properties": {
-                "credit_limit": {
-                    "type": "number"
-                },
-                "credit_type": {
-                    "$ref": "#/definitions/enums.CreditType"
-                },
-                "current_balance": {
-                    "description": "Added CurrentBalance field",
-                    "type": "number"
-                },
-                "grace_period": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "interest_rate": {
-                    "type": "number"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.StatementResponse"
+                    }
                 },
-                "interest_type": {
-                    "$ref": "#/definitions/enums.InterestType"
+                "limit": {
+                    "type": "integer"
                 },
-                "is_blocked": {
-                    "type": "boolean"
+                "next_cursor": {
+                    "description": "Empty on the last page",
+                    "type": "string"
                 },
-                "late_fee_rule_id": {
-                    "description": "Optional",
+                "offset": {
                     "type": "integer"
                 },
-                "monthly_due_date": {
-                    "type": "integer",
-                    "maximum": 31,
-                    "minimum": 1
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateEstablishmentRequest": {
+        "response.PageResponse-response_TransactionResponse": {
             "type": "object",
-            "required": [
-                "address",
-                "name",
-                "phone",
-                "ruc"
-            ],
             "properties": {
-                "address": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.TransactionResponse"
+                    }
                 },
-                "is_active": {
-                    "type": "boolean"
+                "limit": {
+                    "type": "integer"
                 },
-                "name": {
+                "next_cursor": {
+                    "description": "Empty on the last page",
                     "type": "string"
                 },
-                "phone": {
-                    "type": "string"
+                "offset": {
+                    "type": "integer"
                 },
-                "ruc": {
-                    "type": "string"
+                "total": {
+                    "description": "Items matching the filters, on every page",
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateInstallmentRequest": {
+        "response.PaymentAllocationResponse": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "number"
                 },
-                "due_date": {
-                    "type": "string"
+                "component": {
+                    "$ref": "#/definitions/enums.AllocationComponent"
                 },
-                "status": {
-                    "$ref": "#/definitions/enums.InstallmentStatus"
+                "credit_account_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "installment_id": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateLateFeeRequest": {
+        "response.PrepaymentResponse": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "number"
-                }
-            }
-        },
-        "request.UpdateLateFeeRuleRequest": {
-            "type": "object",
-            "properties": {
-                "days_overdue_max": {
-                    "type": "integer"
                 },
-                "days_overdue_min": {
-                    "type": "integer",
-                    "minimum": 1
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.InstallmentResponse"
+                    }
                 },
-                "fee_type": {
-                    "$ref": "#/definitions/enums.FeeType"
+                "interest_paid": {
+                    "description": "Interest earned up to the prepayment date",
+                    "type": "number"
                 },
-                "fee_value": {
+                "mode": {
+                    "$ref": "#/definitions/enums.PrepaymentMode"
+                },
+                "principal_paid": {
                     "type": "number"
                 },
-                "name": {
-                    "type": "string"
+                "rebated_interest": {
+                    "description": "Scheduled interest no longer charged",
+                    "type": "number"
+                },
+                "remaining_principal": {
+                    "type": "number"
+                },
+                "term": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateProductRequest": {
+        "response.ProductResponse": {
             "type": "object",
-            "required": [
-                "category",
-                "description",
-                "name",
-                "price",
-                "stock"
-            ],
             "properties": {
                 "category": {
                     "$ref": "#/definitions/entities.ProductCategory"
                 },
+                "created_at": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "establishment": {
+                    "$ref": "#/definitions/response.EstablishmentResponse"
+                },
+                "id": {
+                    "type": "integer"
+                },
                 "is_active": {
                     "type": "boolean"
                 },
@@ -3802,383 +8742,327 @@ const docTemplate = `{
                     "type": "number"
                 },
                 "stock": {
-                    "type": "integer",
-                    "minimum": 0
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "request.UpdateTransactionRequest": {
+        "response.PurchaseLineResponse": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "number"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "transaction_type": {
-                    "$ref": "#/definitions/enums.TransactionType"
-                }
-            }
-        },
-        "response.AdminDebtSummary": {
-            "type": "object",
-            "properties": {
-                "client_id": {
+                "id": {
                     "type": "integer"
                 },
-                "client_name": {
-                    "type": "string"
-                },
-                "credit_type": {
-                    "type": "string"
+                "price_overridden": {
+                    "type": "boolean"
                 },
-                "current_balance": {
-                    "type": "number"
+                "product_id": {
+                    "type": "integer"
                 },
-                "due_date": {
-                    "description": "For short-term or next installment",
+                "product_name": {
                     "type": "string"
                 },
-                "interest_rate": {
-                    "type": "number"
+                "quantity": {
+                    "type": "integer"
                 },
-                "number_of_installments": {
-                    "description": "Only for long-term",
+                "transaction_id": {
                     "type": "integer"
+                },
+                "unit_price": {
+                    "type": "number"
                 }
             }
         },
-        "response.AdminResponse": {
+        "response.ReconciliationResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "accounts": {
+                    "description": "Only accounts with a discrepancy",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AccountReconciliationResponse"
+                    }
                 },
-                "establishment": {
-                    "$ref": "#/definitions/response.EstablishmentResponse"
+                "accounts_checked": {
+                    "type": "integer"
                 },
-                "establishment_id": {
+                "adjusted": {
                     "type": "integer"
                 },
-                "id": {
+                "discrepancies": {
                     "type": "integer"
                 },
-                "is_active": {
+                "dry_run": {
                     "type": "boolean"
                 },
-                "updated_at": {
-                    "type": "string"
-                },
-                "user_id": {
+                "establishment_id": {
+                    "description": "0 when every establishment was reconciled",
                     "type": "integer"
+                },
+                "run_at": {
+                    "type": "string"
                 }
             }
         },
-        "response.AuthResponse": {
+        "response.RoleResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "refresh_token": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "response.ClientResponse": {
+        "response.ScheduledJobResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "cron_expression": {
                     "type": "string"
                 },
-                "credit_limit": {
-                    "type": "number"
-                },
-                "email": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "is_active": {
+                "is_paused": {
                     "type": "boolean"
                 },
-                "phone": {
+                "last_run_at": {
                     "type": "string"
                 },
-                "updated_at": {
+                "name": {
                     "type": "string"
                 },
-                "user_id": {
-                    "type": "integer"
+                "next_run_at": {
+                    "type": "string"
                 }
             }
         },
-        "response.CreditAccountResponse": {
+        "response.StatementResponse": {
             "type": "object",
             "properties": {
-                "client": {
-                    "$ref": "#/definitions/response.ClientResponse"
+                "adjustments": {
+                    "type": "number"
                 },
-                "client_id": {
-                    "type": "integer"
+                "amount_due": {
+                    "type": "number"
+                },
+                "closing_balance": {
+                    "type": "number"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "credit_limit": {
-                    "type": "number"
+                "credit_account_id": {
+                    "type": "integer"
                 },
-                "credit_type": {
-                    "$ref": "#/definitions/enums.CreditType"
+                "due_date": {
+                    "type": "string"
                 },
-                "current_balance": {
+                "fees": {
                     "type": "number"
                 },
-                "establishment_id": {
-                    "type": "integer"
-                },
-                "grace_period": {
-                    "type": "integer"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "interest_rate": {
+                "interest": {
                     "type": "number"
                 },
-                "interest_type": {
-                    "$ref": "#/definitions/enums.InterestType"
+                "opening_balance": {
+                    "type": "number"
                 },
-                "is_blocked": {
-                    "type": "boolean"
+                "payments": {
+                    "type": "number"
                 },
-                "last_interest_accrual_date": {
+                "period_end": {
                     "type": "string"
                 },
-                "late_fee_rule": {
-                    "$ref": "#/definitions/response.LateFeeRuleResponse"
-                },
-                "late_fee_rule_id": {
-                    "type": "integer"
-                },
-                "monthly_due_date": {
-                    "type": "integer"
-                },
-                "updated_at": {
+                "period_start": {
                     "type": "string"
+                },
+                "purchases": {
+                    "type": "number"
                 }
             }
         },
-        "response.CreditRequestResponse": {
+        "response.TransactionHistoryResponse": {
             "type": "object",
             "properties": {
-                "approved_at": {
-                    "type": "string"
-                },
-                "client_id": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "credit_type": {
-                    "$ref": "#/definitions/enums.CreditType"
-                },
-                "establishment_id": {
-                    "type": "integer"
+                "closing_balance": {
+                    "description": "Balance at the end of the period",
+                    "type": "number"
                 },
-                "grace_period": {
-                    "type": "integer"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CreditAccountHistoryResponse"
+                    }
                 },
-                "id": {
+                "limit": {
                     "type": "integer"
                 },
-                "interest_type": {
-                    "$ref": "#/definitions/enums.InterestType"
+                "next_cursor": {
+                    "type": "string"
                 },
-                "monthly_due_date": {
+                "offset": {
                     "type": "integer"
                 },
-                "rejected_at": {
-                    "type": "string"
-                },
-                "requested_credit_limit": {
+                "opening_balance": {
+                    "description": "Balance right before the period",
                     "type": "number"
                 },
-                "status": {
-                    "$ref": "#/definitions/entities.CreditRequestStatus"
+                "total": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "response.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
+                "totals": {
+                    "description": "Entries matching the filters, on every page",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.TransactionTypeTotalResponse"
+                    }
                 }
             }
         },
-        "response.EstablishmentResponse": {
+        "response.TransactionResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "admin": {
-                    "$ref": "#/definitions/response.AdminResponse"
+                "amount": {
+                    "type": "number"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "id": {
+                "credit_account_id": {
                     "type": "integer"
                 },
-                "is_active": {
-                    "type": "boolean"
-                },
-                "name": {
+                "description": {
                     "type": "string"
                 },
-                "phone": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "products": {
+                "lines": {
+                    "description": "Items of an itemized purchase",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.ProductResponse"
+                        "$ref": "#/definitions/response.PurchaseLineResponse"
                     }
                 },
-                "ruc": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "response.InstallmentResponse": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "number"
+                "reason_code": {
+                    "$ref": "#/definitions/enums.ReasonCode"
                 },
-                "credit_account_id": {
+                "reversal_of_id": {
                     "type": "integer"
                 },
-                "due_date": {
+                "transaction_date": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "transaction_type": {
+                    "$ref": "#/definitions/enums.TransactionType"
                 },
-                "status": {
-                    "$ref": "#/definitions/enums.InstallmentStatus"
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "response.LateFeeResponse": {
+        "response.TransactionTypeTotalResponse": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "number"
                 },
-                "applied_date": {
-                    "type": "string"
-                },
-                "credit_account_id": {
+                "count": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "integer"
+                "transaction_type": {
+                    "$ref": "#/definitions/enums.TransactionType"
                 }
             }
         },
-        "response.LateFeeRuleResponse": {
+        "response.TrialBalanceLineResponse": {
             "type": "object",
             "properties": {
-                "days_overdue_max": {
-                    "type": "integer"
-                },
-                "days_overdue_min": {
-                    "type": "integer"
-                },
-                "establishment_id": {
-                    "type": "integer"
+                "balance": {
+                    "description": "On the normal side of the account",
+                    "type": "number"
                 },
-                "fee_type": {
-                    "$ref": "#/definitions/enums.FeeType"
+                "code": {
+                    "type": "string"
                 },
-                "fee_value": {
+                "credit": {
                     "type": "number"
                 },
-                "id": {
-                    "type": "integer"
+                "debit": {
+                    "type": "number"
                 },
                 "name": {
                     "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/enums.LedgerAccountType"
                 }
             }
         },
-        "response.ProductResponse": {
+        "response.TrialBalanceResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/entities.ProductCategory"
-                },
-                "created_at": {
-                    "type": "string"
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.TrialBalanceLineResponse"
+                    }
                 },
-                "description": {
+                "as_of": {
                     "type": "string"
                 },
-                "establishment": {
-                    "$ref": "#/definitions/response.EstablishmentResponse"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "is_active": {
+                "balanced": {
                     "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "establishment_id": {
+                    "type": "integer"
                 },
-                "price": {
+                "total_credit": {
                     "type": "number"
                 },
-                "stock": {
-                    "type": "integer"
-                },
-                "updated_at": {
-                    "type": "string"
+                "total_debit": {
+                    "type": "number"
                 }
             }
         },
-        "response.TransactionResponse": {
+        "response.UserResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
+                "admin": {
+                    "$ref": "#/definitions/response.AdminResponse"
+                },
+                "client": {
+                    "$ref": "#/definitions/response.ClientResponse"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "credit_account_id": {
-                    "type": "integer"
-                },
-                "description": {
+                "email": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "transaction_type": {
-                    "$ref": "#/definitions/enums.TransactionType"
+                "name": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "updated_at": {
                     "type": "string"
@@ -0,0 +1,90 @@
+// Package audit computes the diffs and the hash chain of the audit log.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+)
+
+// ignoredFields change on every write or must never be stored.
+var ignoredFields = map[string]bool{"updated_at": true, "password": true}
+
+// Diff returns the fields that differ between two snapshots of a row, as JSON objects with the
+// values before and after. A nil snapshot stands for a row that does not exist.
+func Diff(before, after map[string]interface{}) (string, string) {
+	changedBefore := map[string]interface{}{}
+	changedAfter := map[string]interface{}{}
+	for field := range union(before, after) {
+		if ignoredFields[field] {
+			continue
+		}
+		oldValue, hadOld := before[field]
+		newValue, hasNew := after[field]
+		if hadOld && hasNew && encode(oldValue) == encode(newValue) {
+			continue
+		}
+		if hadOld {
+			changedBefore[field] = oldValue
+		}
+		if hasNew {
+			changedAfter[field] = newValue
+		}
+	}
+	return encode(changedBefore), encode(changedAfter)
+}
+
+// Hash returns the hash of an entry chained to the hash of the previous entry. CreatedAt must
+// already have the precision of the database (microseconds).
+func Hash(entry *entities.AuditLog) string {
+	fields := []string{
+		entry.PrevHash,
+		entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+		strconv.FormatUint(uint64(entry.ActorUserID), 10),
+		strconv.FormatUint(uint64(entry.EstablishmentID), 10),
+		entry.Action,
+		entry.EntityType,
+		entry.EntityID,
+		canonical(entry.Before),
+		canonical(entry.After),
+		entry.IP,
+		entry.RequestID,
+		strconv.Itoa(entry.StatusCode),
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// canonical re-encodes a JSON document, so the hash does not depend on how the database
+// formats a jsonb value (key order, spacing).
+func canonical(document string) string {
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return document
+	}
+	return encode(value)
+}
+
+func encode(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func union(a, b map[string]interface{}) map[string]bool {
+	keys := make(map[string]bool, len(a)+len(b))
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+	return keys
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"errors"
+
+	"ApiRestFinance/internal/model/entities/enums"
+)
+
+// ErrForbidden is returned when the caller may not perform an action on a resource it can see.
+var ErrForbidden = errors.New("caller is not allowed to perform this action")
+
+// Principal is the authenticated caller of a request, resolved once from its access token.
+type Principal struct {
+	UserID          uint
+	Roles           []enums.RoleName
+	EstablishmentID uint // Establishment the caller works at, 0 when it is not staff
+	ClientID        uint // Client record of the caller, 0 when it is not a client
+}
+
+// HasRole reports whether the caller has a role.
+func (p Principal) HasRole(role enums.RoleName) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPlatformAdmin reports whether the caller operates the platform.
+func (p Principal) IsPlatformAdmin() bool {
+	return p.HasRole(enums.PlatformAdminRole)
+}
+
+// Manages reports whether the caller administers an establishment.
+func (p Principal) Manages(establishmentID uint) bool {
+	if p.IsPlatformAdmin() {
+		return true
+	}
+	return p.HasRole(enums.EstablishmentAdminRole) && p.EstablishmentID != 0 && p.EstablishmentID == establishmentID
+}
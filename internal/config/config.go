@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"ApiRestFinance/internal/mailer"
 	"github.com/joho/godotenv"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -11,9 +12,23 @@ import (
 
 // Config struct to hold all configuration values
 type Config struct {
-	DB         *gorm.DB
-	JwtSecret  string
-	ServerPort string
+	DB               *gorm.DB
+	JwtSecret        string
+	ServerPort       string
+	SchedulerEnabled bool
+	InterestJobCron  string
+	LateFeeJobCron   string
+	// Automatic credit limit reviews and delinquency blocks
+	LimitReviewJobCron string
+	DelinquencyJobCron string
+	// Email of the user granted the platform admin role at startup, if any
+	PlatformAdminEmail string
+	// Mailer settings: MailDriver is "smtp" or "log"
+	MailDriver  string
+	MailLogFile string
+	SMTP        mailer.SMTPConfig
+	// Link emailed for password resets, the token is appended to it
+	PasswordResetURL string
 }
 
 // LoadConfig loads configuration from environment variables or .env file
@@ -41,6 +56,49 @@ func LoadConfig() (*Config, error) {
 		serverPort = "8080" // Default port
 	}
 
+	// Scheduler configuration (standard 5-field cron expressions)
+	schedulerEnabled := os.Getenv("SCHEDULER_ENABLED") != "false"
+	interestJobCron := os.Getenv("INTEREST_JOB_CRON")
+	if interestJobCron == "" {
+		interestJobCron = "0 2 * * *" // Every day at 02:00
+	}
+	lateFeeJobCron := os.Getenv("LATE_FEE_JOB_CRON")
+	if lateFeeJobCron == "" {
+		lateFeeJobCron = "0 3 * * *" // Every day at 03:00
+	}
+	limitReviewJobCron := os.Getenv("LIMIT_REVIEW_JOB_CRON")
+	if limitReviewJobCron == "" {
+		limitReviewJobCron = "0 4 * * 1" // Every Monday at 04:00
+	}
+	delinquencyJobCron := os.Getenv("DELINQUENCY_JOB_CRON")
+	if delinquencyJobCron == "" {
+		delinquencyJobCron = "30 3 * * *" // Every day at 03:30, after the late fees
+	}
+
+	// Bootstrap platform admin
+	platformAdminEmail := os.Getenv("PLATFORM_ADMIN_EMAIL")
+
+	// Mailer configuration
+	mailDriver := os.Getenv("MAIL_DRIVER")
+	if mailDriver == "" {
+		mailDriver = "log" // Local development
+	}
+	smtpPort := os.Getenv("SMTP_PORT")
+	if smtpPort == "" {
+		smtpPort = "587"
+	}
+	smtpConfig := mailer.SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     smtpPort,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("MAIL_FROM"),
+	}
+	passwordResetURL := os.Getenv("PASSWORD_RESET_URL")
+	if passwordResetURL == "" {
+		passwordResetURL = "http://localhost:3000/reset-password?token="
+	}
+
 	// Database connection string
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		dbHost, dbPort, dbUser, dbPass, dbName, dbSSLMode)
@@ -53,9 +111,20 @@ func LoadConfig() (*Config, error) {
 
 	// Create Config struct
 	cfg := &Config{
-		DB:         db,
-		JwtSecret:  jwtSecret,
-		ServerPort: serverPort,
+		DB:               db,
+		JwtSecret:        jwtSecret,
+		ServerPort:       serverPort,
+		SchedulerEnabled: schedulerEnabled,
+		InterestJobCron:  interestJobCron,
+		LateFeeJobCron:   lateFeeJobCron,
+
+		LimitReviewJobCron: limitReviewJobCron,
+		DelinquencyJobCron: delinquencyJobCron,
+		PlatformAdminEmail: platformAdminEmail,
+		MailDriver:         mailDriver,
+		MailLogFile:        os.Getenv("MAIL_LOG_FILE"),
+		SMTP:               smtpConfig,
+		PasswordResetURL:   passwordResetURL,
 	}
 
 	return cfg, nil
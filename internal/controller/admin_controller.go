@@ -1,8 +1,8 @@
 package controller
 
 import (
+	"ApiRestFinance/internal/middleware"
 	"ApiRestFinance/internal/model/entities"
-	"github.com/golang-jwt/jwt/v4"
 	"net/http"
 	"strconv"
 
@@ -91,18 +91,31 @@ func (c *AdminController) CreateAdmin(ctx *gin.Context) {
 // @Tags         Admins
 // @Accept       json
 // @Produce      json
-// @Success      200     {array}   response.AdminResponse
+// @Param        limit   query     int     false  "Items per page, up to 200 (default 50)"
+// @Param        offset  query     int     false  "Items to skip"
+// @Param        cursor  query     string  false  "Next cursor of the previous page, replaces offset"
+// @Param        sort    query     string  false  "Sort field, prefixed with - for descending order: id, created_at (default id)"
+// @Param        from    query     string  false  "First day of creation (YYYY-MM-DD)"
+// @Param        to      query     string  false  "Last day of creation (YYYY-MM-DD)"
+// @Success      200     {object}  response.PageResponse[response.AdminResponse]
+// @Failure      400     {object}  map[string]string  "Invalid query"
 // @Failure      500     {object}  map[string]string  "Internal server error"
 // @Router       /api/v1/admins [get]
 func (c *AdminController) GetAllAdmins(ctx *gin.Context) {
-	admins, err := c.adminService.GetAllAdmins()
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	admins, err := c.adminService.GetAllAdmins(query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(listErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	var resp []response.AdminResponse
-	for _, admin := range admins {
+	resp := make([]response.AdminResponse, 0, len(admins.Items))
+	for _, admin := range admins.Items {
 		// Get the associated establishment
 		establishment, err := c.adminService.GetEstablishmentByID(admin.EstablishmentID)
 		if err != nil {
@@ -136,7 +149,7 @@ func (c *AdminController) GetAllAdmins(ctx *gin.Context) {
 		})
 	}
 
-	ctx.JSON(http.StatusOK, resp)
+	ctx.JSON(http.StatusOK, pageWith(admins, resp))
 }
 
 // GetAdminByID godoc
@@ -267,7 +280,7 @@ func (c *AdminController) DeleteAdmin(ctx *gin.Context) {
 
 // RegisterEstablishment godoc
 // @Summary      Register establishment
-// @Description  Registers a new establishment associated with the admin.
+// @Description  Registers a new establishment associated with the admin. Only platform admins can register establishments.
 // @Tags         Admins
 // @Accept       json
 // @Produce      json
@@ -276,6 +289,7 @@ func (c *AdminController) DeleteAdmin(ctx *gin.Context) {
 // @Success      201     {object}  response.EstablishmentResponse
 // @Failure      400     {object}  map[string]string  "Invalid request"
 // @Failure      401     {object}  map[string]string  "Unauthorized"
+// @Failure      403     {object}  map[string]string  "Insufficient permissions"
 // @Failure      500     {object}  map[string]string  "Internal server error"
 // @Router       /api/v1/establishments [post]
 func (c *AdminController) RegisterEstablishment(ctx *gin.Context) {
@@ -285,25 +299,7 @@ func (c *AdminController) RegisterEstablishment(ctx *gin.Context) {
 		return
 	}
 
-	claims, exists := ctx.Get("claims")
-	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-
-	claimsMap, ok := claims.(jwt.MapClaims)
-	if !ok {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	userIDFloat, ok := claimsMap["user_id"].(float64)
-	if !ok {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	userID := uint(userIDFloat)
+	userID := middleware.GetUserIDFromContext(ctx)
 
 	establishment := &entities.Establishment{
 		RUC:      req.RUC,
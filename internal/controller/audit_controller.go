@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+
+	"ApiRestFinance/internal/middleware"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// AuditController handles API requests related to the audit log.
+type AuditController struct {
+	auditService service.AuditService
+}
+
+// NewAuditController creates a new AuditController.
+func NewAuditController(auditService service.AuditService) *AuditController {
+	return &AuditController{auditService: auditService}
+}
+
+// GetAuditLogs godoc
+// @Summary Get audit log entries
+// @Description Lists the state-changing requests, newest first. Establishment admins only see the entries of their establishment.
+// @Tags Audit
+// @Produce json
+// @Param actor_id query int false "User who made the request"
+// @Param establishment_id query int false "Establishment the entity belongs to"
+// @Param action query string false "Method and route, e.g. PUT /api/v1/credit-accounts/:id"
+// @Param entity_type query string false "Entity type, e.g. credit-accounts"
+// @Param entity_id query string false "Entity ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, created_at (default -id)"
+// @Success 200 {object} response.PageResponse[response.AuditLogResponse]
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/audit-logs [get]
+func (c *AuditController) GetAuditLogs(ctx *gin.Context) {
+	var query request.AuditLogQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	page, err := c.auditService.GetAuditLogs(query, middleware.TenantFromContext(ctx))
+	if err != nil {
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, page)
+}
+
+// VerifyAuditLog godoc
+// @Summary Verify the audit log
+// @Description Recomputes the hash chain of the audit log and reports the first entry that was changed, or that follows a removed entry.
+// @Tags Audit
+// @Produce json
+// @Success 200 {object} response.AuditVerificationResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/audit-logs/verify [get]
+func (c *AuditController) VerifyAuditLog(ctx *gin.Context) {
+	result, err := c.auditService.VerifyAuditLog()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
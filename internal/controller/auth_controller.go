@@ -1,11 +1,12 @@
 package controller
 
 import (
+	"ApiRestFinance/internal/middleware"
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/service"
 
-	"github.com/golang-jwt/jwt/v4"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -71,7 +72,7 @@ func (c *AuthController) Login(ctx *gin.Context) {
 
 // RefreshToken godoc
 // @Summary      Refresh access token
-// @Description  Generates a new access token from a valid refresh token.
+// @Description  Rotates a refresh token: returns a new access token and a new refresh token, and revokes the one presented. Reusing a rotated refresh token revokes the whole session.
 // @Tags         Authentication
 // @Accept       json
 // @Produce      json
@@ -80,7 +81,7 @@ func (c *AuthController) Login(ctx *gin.Context) {
 // @Failure      401  {object}  map[string]string  "Invalid or expired refresh token."
 // @Router       /api/v1/refresh [post]
 func (c *AuthController) RefreshToken(ctx *gin.Context) {
-	refreshToken := ctx.GetHeader("Authorization")
+	refreshToken := bearerToken(ctx)
 	if refreshToken == "" {
 		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token not provided."})
 		return
@@ -114,31 +115,65 @@ func (c *AuthController) ResetPassword(ctx *gin.Context) {
 		return
 	}
 
-	claims, exists := ctx.Get("claims")
-	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+	userID := middleware.GetUserIDFromContext(ctx)
+
+	err := c.authService.ResetPassword(&req, userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	claimsMap, ok := claims.(jwt.MapClaims)
-	if !ok {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	ctx.JSON(http.StatusOK, gin.H{"message": "Password reset successfully"})
+}
+
+// Logout godoc
+// @Summary      Log out
+// @Description  Revokes the session of a refresh token. Access tokens already issued stay valid until they expire.
+// @Tags         Authentication
+// @Produce      json
+// @Param        Authorization  header      string  true  "Bearer {refreshToken}"
+// @Success      200  {object}  map[string]string
+// @Failure      401  {object}  map[string]string  "Invalid refresh token."
+// @Router       /api/v1/logout [post]
+func (c *AuthController) Logout(ctx *gin.Context) {
+	refreshToken := bearerToken(ctx)
+	if refreshToken == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token not provided."})
 		return
 	}
 
-	userIDFloat, ok := claimsMap["user_id"].(float64)
-	if !ok {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	if err := c.authService.Logout(refreshToken); err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
 
-	userID := uint(userIDFloat)
+	ctx.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
+}
 
-	err := c.authService.ResetPassword(&req, userID)
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+// LogoutAll godoc
+// @Summary      Log out everywhere
+// @Description  Revokes every session of the authenticated user.
+// @Tags         Authentication
+// @Produce      json
+// @Param        Authorization  header      string  true  "Bearer {accessToken}"
+// @Success      200  {object}  map[string]string
+// @Failure      401  {object}  map[string]string
+// @Failure      500  {object}  map[string]string
+// @Router       /api/v1/logout-all [post]
+func (c *AuthController) LogoutAll(ctx *gin.Context) {
+	if err := c.authService.LogoutAll(middleware.GetUserIDFromContext(ctx)); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"message": "Password reset successfully"})
+	ctx.JSON(http.StatusOK, gin.H{"message": "Logged out of every session"})
+}
+
+// bearerToken returns the token of the Authorization header, with or without the Bearer prefix.
+func bearerToken(ctx *gin.Context) string {
+	header := strings.TrimSpace(ctx.GetHeader("Authorization"))
+	if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return header
 }
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"ApiRestFinance/internal/middleware"
 	"ApiRestFinance/internal/model/entities"
 
 	"ApiRestFinance/internal/model/dto/request"
@@ -62,22 +63,37 @@ func (c *ClientController) CreateClient(ctx *gin.Context) {
 
 // GetAllClients godoc
 // @Summary      Get all clients
-// @Description  Gets a list of all clients.
+// @Description  Gets the clients of the caller's establishment, or every client for platform admins.
 // @Tags         Clients
 // @Accept       json
 // @Produce      json
-// @Success      200     {array}   response.ClientResponse
+// @Param        limit       query     int     false  "Items per page, up to 200 (default 50)"
+// @Param        offset      query     int     false  "Items to skip"
+// @Param        cursor      query     string  false  "Next cursor of the previous page, replaces offset"
+// @Param        sort        query     string  false  "Sort field, prefixed with - for descending order: id, email, credit_limit, created_at (default id)"
+// @Param        from        query     string  false  "First day of registration (YYYY-MM-DD)"
+// @Param        to          query     string  false  "Last day of registration (YYYY-MM-DD)"
+// @Param        min_amount  query     number  false  "Minimum credit limit"
+// @Param        max_amount  query     number  false  "Maximum credit limit"
+// @Success      200     {object}  response.PageResponse[response.ClientResponse]
+// @Failure      400     {object}  map[string]string  "Invalid query"
 // @Failure      500     {object}  map[string]string  "Internal server error"
 // @Router       /api/v1/clients [get]
 func (c *ClientController) GetAllClients(ctx *gin.Context) {
-	clients, err := c.clientService.GetAllClients()
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	clients, err := c.clientService.GetAllClients(query, middleware.TenantFromContext(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(listErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	var resp []response.ClientResponse
-	for _, client := range clients {
+	resp := make([]response.ClientResponse, 0, len(clients.Items))
+	for _, client := range clients.Items {
 		resp = append(resp, response.ClientResponse{
 			ID:          client.ID,          // Access ID from entities.Client
 			UserID:      client.UserID,      // Access UserID from entities.Client
@@ -90,7 +106,7 @@ func (c *ClientController) GetAllClients(ctx *gin.Context) {
 		})
 	}
 
-	ctx.JSON(http.StatusOK, resp)
+	ctx.JSON(http.StatusOK, pageWith(clients, resp))
 }
 
 // GetClientByID godoc
@@ -5,9 +5,11 @@ import (
 	"net/http"
 	"strconv"
 
+	"ApiRestFinance/internal/auth"
 	"ApiRestFinance/internal/middleware"
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
 	"ApiRestFinance/internal/service"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
@@ -83,7 +85,7 @@ func (c *CreditAccountController) GetCreditAccountByID(ctx *gin.Context) {
 
 // UpdateCreditAccount godoc
 // @Summary Update a credit account
-// @Description Updates an existing credit account by its ID.
+// @Description Updates an existing credit account by its ID. A new credit limit is recorded in the history of the account and may not be lower than its balance; lower limits go through a credit limit change approved with an override.
 // @Tags CreditAccounts
 // @Accept json
 // @Produce json
@@ -92,6 +94,7 @@ func (c *CreditAccountController) GetCreditAccountByID(ctx *gin.Context) {
 // @Success 200 {object} response.CreditAccountResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/credit-accounts/{id} [put]
 func (c *CreditAccountController) UpdateCreditAccount(ctx *gin.Context) {
@@ -113,6 +116,10 @@ func (c *CreditAccountController) UpdateCreditAccount(ctx *gin.Context) {
 			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
 			return
 		}
+		if errors.Is(err, repository.ErrLimitBelowBalance) {
+			ctx.JSON(http.StatusUnprocessableEntity, response.ErrorResponse{Error: err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -152,24 +159,39 @@ func (c *CreditAccountController) DeleteCreditAccount(ctx *gin.Context) {
 
 // GetCreditAccountsByEstablishmentID godoc
 // @Summary Get credit accounts by establishment ID
-// @Description Retrieves all credit accounts associated with an establishment.
+// @Description Lists the credit accounts of an establishment. The date filters apply to the opening date.
 // @Tags CreditAccounts
 // @Produce json
-// @Param establishmentID path int true "Establishment ID"
-// @Success 200 {array} response.CreditAccountResponse
+// @Param establishment_id path int true "Establishment ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, created_at, credit_limit, current_balance (default id)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum current balance"
+// @Param max_amount query number false "Maximum current balance"
+// @Param credit_type query string false "Credit type" Enums(SHORT_TERM, LONG_TERM)
+// @Success 200 {object} response.PageResponse[response.CreditAccountResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{establishmentID}/credit-accounts [get]
+// @Router /api/v1/establishments/{establishment_id}/credit-accounts [get]
 func (c *CreditAccountController) GetCreditAccountsByEstablishmentID(ctx *gin.Context) {
-	establishmentID, err := strconv.Atoi(ctx.Param("establishmentID"))
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
 	}
 
-	creditAccounts, err := c.creditAccountService.GetCreditAccountsByEstablishmentID(uint(establishmentID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	creditAccounts, err := c.creditAccountService.GetCreditAccountsByEstablishmentID(uint(establishmentID), query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -178,24 +200,40 @@ func (c *CreditAccountController) GetCreditAccountsByEstablishmentID(ctx *gin.Co
 
 // GetCreditAccountsByClientID godoc
 // @Summary Get credit accounts by client ID
-// @Description Retrieves all credit accounts associated with a client.
+// @Description Retrieves the credit accounts of a client at the caller's establishment, or at every establishment for the client itself.
 // @Tags CreditAccounts
 // @Produce json
-// @Param clientID path int true "Client ID"
-// @Success 200 {array} response.CreditAccountResponse
+// @Param id path int true "Client ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, created_at, credit_limit, current_balance (default id)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum current balance"
+// @Param max_amount query number false "Maximum current balance"
+// @Param credit_type query string false "Credit type" Enums(SHORT_TERM, LONG_TERM)
+// @Success 200 {object} response.PageResponse[response.CreditAccountResponse]
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/clients/{clientID}/credit-accounts [get]
+// @Router /api/v1/clients/{id}/credit-accounts [get]
 func (c *CreditAccountController) GetCreditAccountsByClientID(ctx *gin.Context) {
-	clientID, err := strconv.Atoi(ctx.Param("clientID"))
+	clientID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid client ID"})
 		return
 	}
 
-	creditAccounts, err := c.creditAccountService.GetCreditAccountsByClientID(uint(clientID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	creditAccounts, err := c.creditAccountService.GetCreditAccountsByClientID(uint(clientID), query, middleware.TenantFromContext(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -207,13 +245,13 @@ func (c *CreditAccountController) GetCreditAccountsByClientID(ctx *gin.Context)
 // @Description Applies interest to all eligible credit accounts within an establishment.
 // @Tags CreditAccounts
 // @Produce json
-// @Param establishmentID path int true "Establishment ID"
+// @Param establishment_id path int true "Establishment ID"
 // @Success 200 "Interest applied successfully"
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{establishmentID}/credit-accounts/apply-interest [post]
+// @Router /api/v1/establishments/{establishment_id}/credit-accounts/apply-interest [post]
 func (c *CreditAccountController) ApplyInterestToAllAccounts(ctx *gin.Context) {
-	establishmentID, err := strconv.Atoi(ctx.Param("establishmentID"))
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -232,13 +270,13 @@ func (c *CreditAccountController) ApplyInterestToAllAccounts(ctx *gin.Context) {
 // @Description Applies late fees to all eligible credit accounts within an establishment.
 // @Tags CreditAccounts
 // @Produce json
-// @Param establishmentID path int true "Establishment ID"
+// @Param establishment_id path int true "Establishment ID"
 // @Success 200 "Late fees applied successfully"
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{establishmentID}/credit-accounts/apply-late-fees [post]
+// @Router /api/v1/establishments/{establishment_id}/credit-accounts/apply-late-fees [post]
 func (c *CreditAccountController) ApplyLateFeesToAllAccounts(ctx *gin.Context) {
-	establishmentID, err := strconv.Atoi(ctx.Param("establishmentID"))
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -257,13 +295,13 @@ func (c *CreditAccountController) ApplyLateFeesToAllAccounts(ctx *gin.Context) {
 // @Description Retrieves a summary of debts owed to an establishment.
 // @Tags CreditAccounts
 // @Produce json
-// @Param establishmentID path int true "Establishment ID"
+// @Param establishment_id path int true "Establishment ID"
 // @Success 200 {array} response.AdminDebtSummary
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{establishmentID}/credit-accounts/debt-summary [get]
+// @Router /api/v1/establishments/{establishment_id}/credit-accounts/debt-summary [get]
 func (c *CreditAccountController) GetAdminDebtSummary(ctx *gin.Context) {
-	establishmentID, err := strconv.Atoi(ctx.Param("establishmentID"))
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -284,15 +322,18 @@ func (c *CreditAccountController) GetAdminDebtSummary(ctx *gin.Context) {
 // @Tags CreditAccounts
 // @Accept json
 // @Produce json
-// @Param creditAccountID path int true "Credit Account ID"
+// @Param id path int true "Credit Account ID"
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
 // @Param purchase body request.CreateTransactionRequest true "Purchase details"
 // @Success 201 "Purchase processed successfully"
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/credit-accounts/{creditAccountID}/purchases [post]
+// @Router /api/v1/credit-accounts/{id}/purchases [post]
 func (c *CreditAccountController) ProcessPurchase(ctx *gin.Context) {
-	creditAccountID, err := strconv.Atoi(ctx.Param("creditAccountID"))
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
 		return
@@ -308,28 +349,82 @@ func (c *CreditAccountController) ProcessPurchase(ctx *gin.Context) {
 	// such as checking if the transaction type is Purchase.
 
 	if err := c.creditAccountService.ProcessPurchase(uint(creditAccountID), req.Amount, req.Description); err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		c.handlePurchaseError(ctx, err)
 		return
 	}
 
 	ctx.JSON(http.StatusCreated, gin.H{"message": "Purchase processed successfully"})
 }
 
+// ProcessItemizedPurchase godoc
+// @Summary Process an itemized purchase
+// @Description Charges products of the establishment of a credit account. Each item may override the catalog unit price. Products must be active and have enough stock, which is decremented together with the balance. The lines are shown in the transaction and in the account history.
+// @Tags CreditAccounts
+// @Accept json
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
+// @Param purchase body request.CreatePurchaseRequest true "Purchase items"
+// @Success 201 {object} response.TransactionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/purchases/itemized [post]
+func (c *CreditAccountController) ProcessItemizedPurchase(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	var req request.CreatePurchaseRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	purchase, err := c.creditAccountService.ProcessItemizedPurchase(uint(creditAccountID), req)
+	if err != nil {
+		c.handlePurchaseError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, purchase)
+}
+
+// handlePurchaseError answers the errors of charging a purchase.
+func (c *CreditAccountController) handlePurchaseError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
+	case errors.Is(err, repository.ErrAccountBlocked), errors.Is(err, repository.ErrCreditLimitExceeded),
+		errors.Is(err, repository.ErrInvalidPurchase), errors.Is(err, repository.ErrInsufficientStock):
+		ctx.JSON(http.StatusUnprocessableEntity, response.ErrorResponse{Error: err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+	}
+}
+
 // ProcessPayment godoc
 // @Summary Process a payment
 // @Description Processes a payment transaction on a credit account.
 // @Tags CreditAccounts
 // @Accept json
 // @Produce json
-// @Param creditAccountID path int true "Credit Account ID"
+// @Param id path int true "Credit Account ID"
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
 // @Param payment body request.CreateTransactionRequest true "Payment details"
 // @Success 201 "Payment processed successfully"
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/credit-accounts/{creditAccountID}/payments [post]
+// @Router /api/v1/credit-accounts/{id}/payments [post]
 func (c *CreditAccountController) ProcessPayment(ctx *gin.Context) {
-	creditAccountID, err := strconv.Atoi(ctx.Param("creditAccountID"))
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
 		return
@@ -354,7 +449,7 @@ func (c *CreditAccountController) ProcessPayment(ctx *gin.Context) {
 
 // CreateCreditRequest godoc
 // @Summary Create a credit request
-// @Description Creates a new credit request for a client.
+// @Description Creates a new credit request for a client. The client is scored from their history across establishments and the credit policy of the establishment approves, rejects or leaves the request pending for manual review; the score and the reasons of the decision are stored on the request.
 // @Tags CreditRequests
 // @Accept json
 // @Produce json
@@ -418,6 +513,7 @@ func (c *CreditAccountController) GetCreditRequestByID(ctx *gin.Context) {
 // @Param id path int true "Credit Request ID"
 // @Success 200 {object} response.CreditAccountResponse
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/credit-requests/{id}/approve [put]
@@ -428,15 +524,16 @@ func (c *CreditAccountController) ApproveCreditRequest(ctx *gin.Context) {
 		return
 	}
 
-	// Assuming you have middleware to retrieve the authenticated admin\'s ID
-	adminID := middleware.GetUserIDFromContext(ctx)
-
-	creditAccount, err := c.creditAccountService.ApproveCreditRequest(uint(id), adminID)
+	creditAccount, err := c.creditAccountService.ApproveCreditRequest(uint(id), middleware.PrincipalFromContext(ctx))
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit request not found"})
 			return
 		}
+		if errors.Is(err, auth.ErrForbidden) {
+			ctx.JSON(http.StatusForbidden, response.ErrorResponse{Error: err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -452,6 +549,7 @@ func (c *CreditAccountController) ApproveCreditRequest(ctx *gin.Context) {
 // @Param id path int true "Credit Request ID"
 // @Success 200 "Credit request rejected successfully"
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/credit-requests/{id}/reject [put]
@@ -462,14 +560,15 @@ func (c *CreditAccountController) RejectCreditRequest(ctx *gin.Context) {
 		return
 	}
 
-	// Assuming you have middleware to retrieve the authenticated admin's ID
-	adminID := middleware.GetUserIDFromContext(ctx)
-
-	if err := c.creditAccountService.RejectCreditRequest(uint(id), adminID); err != nil {
+	if err := c.creditAccountService.RejectCreditRequest(uint(id), middleware.PrincipalFromContext(ctx)); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit request not found"})
 			return
 		}
+		if errors.Is(err, auth.ErrForbidden) {
+			ctx.JSON(http.StatusForbidden, response.ErrorResponse{Error: err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -479,24 +578,39 @@ func (c *CreditAccountController) RejectCreditRequest(ctx *gin.Context) {
 
 // GetPendingCreditRequests godoc
 // @Summary Get pending credit requests
-// @Description Retrieves all pending credit requests for an establishment.
+// @Description Lists the pending credit requests of an establishment.
 // @Tags CreditRequests
 // @Produce json
-// @Param establishmentID path int true "Establishment ID"
-// @Success 200 {array} response.CreditRequestResponse
+// @Param establishment_id path int true "Establishment ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, created_at, requested_credit_limit (default created_at)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum requested credit limit"
+// @Param max_amount query number false "Maximum requested credit limit"
+// @Param credit_type query string false "Credit type" Enums(SHORT_TERM, LONG_TERM)
+// @Success 200 {object} response.PageResponse[response.CreditRequestResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{establishmentID}/credit-requests/pending [get]
+// @Router /api/v1/establishments/{establishment_id}/credit-requests/pending [get]
 func (c *CreditAccountController) GetPendingCreditRequests(ctx *gin.Context) {
-	establishmentID, err := strconv.Atoi(ctx.Param("establishmentID"))
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
 	}
 
-	creditRequests, err := c.creditAccountService.GetPendingCreditRequests(uint(establishmentID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	creditRequests, err := c.creditAccountService.GetPendingCreditRequests(uint(establishmentID), query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -540,3 +654,94 @@ func (c *CreditAccountController) AssignCreditAccountToClient(ctx *gin.Context)
 
 	ctx.JSON(http.StatusOK, updatedCreditAccount)
 }
+
+// RegenerateSchedule godoc
+// @Summary Regenerate the installment schedule
+// @Description Rebuilds the unpaid installments of a LONG_TERM credit account, optionally with a new term or amortization method.
+// @Tags CreditAccounts
+// @Accept json
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param schedule body request.RegenerateScheduleRequest false "New schedule terms"
+// @Success 200 {array} response.InstallmentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/installments/regenerate [post]
+func (c *CreditAccountController) RegenerateSchedule(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	var req request.RegenerateScheduleRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	installments, err := c.creditAccountService.RegenerateSchedule(uint(creditAccountID), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
+		case errors.Is(err, repository.ErrNotLongTerm):
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, installments)
+}
+
+// Prepay godoc
+// @Summary Prepay a LONG_TERM credit account
+// @Description Pays off part or all of the outstanding principal ahead of the schedule. The amount first covers the interest earned since the last due date; the scheduled interest beyond today is rebated and the open installments are replaced by a schedule for the remaining principal that keeps the installment amount (REDUCE_TERM) or the term (REDUCE_INSTALLMENT). Amounts due must be paid with a regular payment first.
+// @Tags CreditAccounts
+// @Accept json
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
+// @Param prepayment body request.PrepaymentRequest true "Prepayment details"
+// @Success 201 {object} response.PrepaymentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/prepayments [post]
+func (c *CreditAccountController) Prepay(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	var req request.PrepaymentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	prepayment, err := c.creditAccountService.Prepay(uint(creditAccountID), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
+		case errors.Is(err, repository.ErrNotLongTerm):
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, repository.ErrArrearsOutstanding), errors.Is(err, repository.ErrInvalidPrepayment):
+			ctx.JSON(http.StatusUnprocessableEntity, response.ErrorResponse{Error: err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, prepayment)
+}
@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"ApiRestFinance/internal/auth"
+	"ApiRestFinance/internal/middleware"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// CreditLimitController handles API requests related to credit limit changes.
+type CreditLimitController struct {
+	creditLimitService service.CreditLimitService
+}
+
+// NewCreditLimitController creates a new CreditLimitController.
+func NewCreditLimitController(creditLimitService service.CreditLimitService) *CreditLimitController {
+	return &CreditLimitController{creditLimitService: creditLimitService}
+}
+
+// RequestLimitChange godoc
+// @Summary Request a credit limit change
+// @Description Requests a new credit limit for a credit account. The change is applied once an establishment admin approves it; an account has at most one pending change.
+// @Tags CreditLimitChanges
+// @Accept json
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param limitChange body request.CreateCreditLimitChangeRequest true "Requested credit limit"
+// @Success 201 {object} response.CreditLimitChangeResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/limit-changes [post]
+func (c *CreditLimitController) RequestLimitChange(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	var req request.CreateCreditLimitChangeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	change, err := c.creditLimitService.RequestLimitChange(uint(creditAccountID), req, middleware.PrincipalFromContext(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
+		case errors.Is(err, service.ErrLimitChangePending):
+			ctx.JSON(http.StatusConflict, response.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, service.ErrLimitUnchanged):
+			ctx.JSON(http.StatusUnprocessableEntity, response.ErrorResponse{Error: err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, change)
+}
+
+// GetLimitChanges godoc
+// @Summary Get the credit limit changes of a credit account
+// @Description Lists the credit limit changes requested for a credit account, including the ones proposed by automatic reviews.
+// @Tags CreditLimitChanges
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, created_at, new_limit (default -created_at)"
+// @Param status query string false "Status (PENDING, APPROVED, REJECTED)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum new limit"
+// @Param max_amount query number false "Maximum new limit"
+// @Success 200 {object} response.PageResponse[response.CreditLimitChangeResponse]
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/limit-changes [get]
+func (c *CreditLimitController) GetLimitChanges(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	changes, err := c.creditLimitService.GetLimitChanges(uint(creditAccountID), query)
+	if err != nil {
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, changes)
+}
+
+// ApproveLimitChange godoc
+// @Summary Approve a credit limit change
+// @Description Applies a pending credit limit change and records it in the history of the credit account. A limit lower than the balance of the account is only applied with override=true.
+// @Tags CreditLimitChanges
+// @Produce json
+// @Param id path int true "Credit Limit Change ID"
+// @Param override query bool false "Allow a limit lower than the current balance"
+// @Success 200 {object} response.CreditLimitChangeResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/limit-changes/{id}/approve [put]
+func (c *CreditLimitController) ApproveLimitChange(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit limit change ID"})
+		return
+	}
+
+	override := false
+	if value := ctx.Query("override"); value != "" {
+		if override, err = strconv.ParseBool(value); err != nil {
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid 'override', expected true or false"})
+			return
+		}
+	}
+
+	change, err := c.creditLimitService.ApproveLimitChange(uint(id), override, middleware.PrincipalFromContext(ctx))
+	if err != nil {
+		c.handleDecisionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, change)
+}
+
+// RejectLimitChange godoc
+// @Summary Reject a credit limit change
+// @Description Closes a pending credit limit change without applying it.
+// @Tags CreditLimitChanges
+// @Produce json
+// @Param id path int true "Credit Limit Change ID"
+// @Success 200 {object} response.CreditLimitChangeResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/limit-changes/{id}/reject [put]
+func (c *CreditLimitController) RejectLimitChange(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit limit change ID"})
+		return
+	}
+
+	change, err := c.creditLimitService.RejectLimitChange(uint(id), middleware.PrincipalFromContext(ctx))
+	if err != nil {
+		c.handleDecisionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, change)
+}
+
+// ReviewCreditLimits godoc
+// @Summary Review the credit limits of an establishment
+// @Description Scores the clients of every unblocked credit account of an establishment and proposes the limit changes its credit policy calls for. Proposals are left pending for approval. The review also runs on a schedule.
+// @Tags CreditLimitChanges
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Success 200 "Credit limits reviewed successfully"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/credit-accounts/review-limits [post]
+func (c *CreditLimitController) ReviewCreditLimits(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	if err := c.creditLimitService.ReviewCreditLimits(uint(establishmentID)); err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Credit limits reviewed successfully"})
+}
+
+// handleDecisionError answers the errors of approving or rejecting a credit limit change.
+func (c *CreditLimitController) handleDecisionError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit limit change not found"})
+	case errors.Is(err, auth.ErrForbidden):
+		ctx.JSON(http.StatusForbidden, response.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, repository.ErrLimitChangeDecided):
+		ctx.JSON(http.StatusConflict, response.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, repository.ErrLimitBelowBalance):
+		ctx.JSON(http.StatusUnprocessableEntity, response.ErrorResponse{Error: err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+	}
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// CreditPolicyController handles API requests related to credit policies.
+type CreditPolicyController struct {
+	creditPolicyService service.CreditPolicyService
+}
+
+// NewCreditPolicyController creates a new CreditPolicyController.
+func NewCreditPolicyController(creditPolicyService service.CreditPolicyService) *CreditPolicyController {
+	return &CreditPolicyController{creditPolicyService: creditPolicyService}
+}
+
+// GetCreditPolicy godoc
+// @Summary Get the credit policy of an establishment
+// @Description Gets the thresholds used to decide the credit requests of an establishment from the credit score of the client.
+// @Tags CreditPolicies
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Success 200 {object} response.CreditPolicyResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/credit-policy [get]
+func (c *CreditPolicyController) GetCreditPolicy(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	policy, err := c.creditPolicyService.GetCreditPolicy(uint(establishmentID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit policy not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
+
+// UpdateCreditPolicy godoc
+// @Summary Set the credit policy of an establishment
+// @Description Sets the thresholds used to decide new credit requests. Scores range from 0 to 1000; requests scoring at least auto_approve_min_score are approved, those below auto_reject_below_score are rejected and the rest are left for manual review, as are requests above max_auto_approve_limit and requests asking for a TEA below min_interest_rate, a grace period longer than max_grace_period or a LONG_TERM term longer than max_term.
+// @Tags CreditPolicies
+// @Accept json
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Param creditPolicy body request.UpdateCreditPolicyRequest true "Credit policy"
+// @Success 200 {object} response.CreditPolicyResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/credit-policy [put]
+func (c *CreditPolicyController) UpdateCreditPolicy(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	var req request.UpdateCreditPolicyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	policy, err := c.creditPolicyService.UpdateCreditPolicy(uint(establishmentID), req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// DelinquencyController handles API requests related to delinquency policies.
+type DelinquencyController struct {
+	delinquencyService service.DelinquencyService
+}
+
+// NewDelinquencyController creates a new DelinquencyController.
+func NewDelinquencyController(delinquencyService service.DelinquencyService) *DelinquencyController {
+	return &DelinquencyController{delinquencyService: delinquencyService}
+}
+
+// GetDelinquencyPolicy godoc
+// @Summary Get the delinquency policy of an establishment
+// @Description Gets the rules used to block and unblock the credit accounts of an establishment from their arrears.
+// @Tags DelinquencyPolicies
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Success 200 {object} response.DelinquencyPolicyResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/delinquency-policy [get]
+func (c *DelinquencyController) GetDelinquencyPolicy(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	policy, err := c.delinquencyService.GetDelinquencyPolicy(uint(establishmentID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Delinquency policy not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
+
+// UpdateDelinquencyPolicy godoc
+// @Summary Set the delinquency policy of an establishment
+// @Description Sets the rules applied by the delinquency batch job. Accounts overdue at least block_after_days_overdue days are blocked; with unblock_when_cleared, accounts the policy blocked are unblocked once they have no overdue installments or balance. Blocks placed by staff or write-offs are never lifted automatically.
+// @Tags DelinquencyPolicies
+// @Accept json
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Param delinquencyPolicy body request.UpdateDelinquencyPolicyRequest true "Delinquency policy"
+// @Success 200 {object} response.DelinquencyPolicyResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/delinquency-policy [put]
+func (c *DelinquencyController) UpdateDelinquencyPolicy(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	var req request.UpdateDelinquencyPolicyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	policy, err := c.delinquencyService.UpdateDelinquencyPolicy(uint(establishmentID), req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
+
+// ApplyDelinquencyPolicy godoc
+// @Summary Apply the delinquency policy of an establishment
+// @Description Blocks and unblocks the credit accounts of an establishment as its delinquency policy calls for, recording each change with its reason in the account history. The policy also runs on a schedule.
+// @Tags DelinquencyPolicies
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Success 200 "Delinquency policy applied successfully"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/credit-accounts/apply-delinquency-policy [post]
+func (c *DelinquencyController) ApplyDelinquencyPolicy(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	if err := c.delinquencyService.ApplyDelinquencyPolicy(uint(establishmentID)); err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Delinquency policy applied successfully"})
+}
@@ -47,13 +47,13 @@ func (c *EstablishmentController) GetAllEstablishments(ctx *gin.Context) {
 // @Description Retrieve an establishment by its ID.
 // @Tags Establishments
 // @Produce json
-// @Param id path uint true "Establishment ID"
+// @Param establishment_id path uint true "Establishment ID"
 // @Success 200 {object} response.EstablishmentResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{id} [get]
+// @Router /api/v1/establishments/{establishment_id} [get]
 func (c *EstablishmentController) GetEstablishmentByID(ctx *gin.Context) {
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := strconv.ParseUint(ctx.Param("establishment_id"), 10, 64)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -105,15 +105,15 @@ func (c *EstablishmentController) CreateEstablishment(ctx *gin.Context) {
 // @Tags Establishments
 // @Accept json
 // @Produce json
-// @Param id path uint true "Establishment ID"
+// @Param establishment_id path uint true "Establishment ID"
 // @Param establishment body request.UpdateEstablishmentRequest true "Establishment details"
 // @Success 200 {object} response.EstablishmentResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{id} [put]
+// @Router /api/v1/establishments/{establishment_id} [put]
 func (c *EstablishmentController) UpdateEstablishment(ctx *gin.Context) {
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := strconv.ParseUint(ctx.Param("establishment_id"), 10, 64)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -143,13 +143,13 @@ func (c *EstablishmentController) UpdateEstablishment(ctx *gin.Context) {
 // @Description Delete an establishment by ID.
 // @Tags Establishments
 // @Produce json
-// @Param id path uint true "Establishment ID"
+// @Param establishment_id path uint true "Establishment ID"
 // @Success 204
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{id} [delete]
+// @Router /api/v1/establishments/{establishment_id} [delete]
 func (c *EstablishmentController) DeleteEstablishment(ctx *gin.Context) {
-	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	id, err := strconv.ParseUint(ctx.Param("establishment_id"), 10, 64)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -174,15 +174,15 @@ func (c *EstablishmentController) DeleteEstablishment(ctx *gin.Context) {
 // @Tags Establishments
 // @Accept json
 // @Produce json
-// @Param id path uint true "Establishment ID"
+// @Param establishment_id path uint true "Establishment ID"
 // @Param products body []uint true "List of product IDs"
 // @Success 204
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{id}/products [post]
+// @Router /api/v1/establishments/{establishment_id}/products [post]
 func (c *EstablishmentController) RegisterProducts(ctx *gin.Context) {
-	establishmentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	establishmentID, err := strconv.ParseUint(ctx.Param("establishment_id"), 10, 64)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -209,18 +209,18 @@ func (c *EstablishmentController) RegisterProducts(ctx *gin.Context) {
 
 // AddClientToEstablishment godoc
 // @Summary Add a client to an establishment
-// @Description Associate a client with an establishment.
+// @Description Associate a client with an establishment. Staff may only add clients who have a credit request or a credit account with their establishment.
 // @Tags Establishments
 // @Produce json
-// @Param id path uint true "Establishment ID"
+// @Param establishment_id path uint true "Establishment ID"
 // @Param client_id path uint true "Client ID"
 // @Success 204
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{id}/clients/{client_id} [put]
+// @Router /api/v1/establishments/{establishment_id}/clients/{client_id} [put]
 func (c *EstablishmentController) AddClientToEstablishment(ctx *gin.Context) {
-	establishmentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	establishmentID, err := strconv.ParseUint(ctx.Param("establishment_id"), 10, 64)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
@@ -151,24 +151,39 @@ func (c *InstallmentController) DeleteInstallment(ctx *gin.Context) {
 
 // GetInstallmentsByCreditAccountID godoc
 // @Summary Get installments by credit account ID
-// @Description Retrieves all installments for a specific credit account.
+// @Description Lists the installments of a credit account.
 // @Tags Installments
 // @Produce json
-// @Param creditAccountID path int true "Credit Account ID"
-// @Success 200 {array} response.InstallmentResponse
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, number, due_date, amount (default due_date)"
+// @Param status query string false "Installment status"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum amount"
+// @Param max_amount query number false "Maximum amount"
+// @Success 200 {object} response.PageResponse[response.InstallmentResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/credit-accounts/{creditAccountID}/installments [get]
+// @Router /api/v1/credit-accounts/{id}/installments [get]
 func (c *InstallmentController) GetInstallmentsByCreditAccountID(ctx *gin.Context) {
-	creditAccountID, err := strconv.Atoi(ctx.Param("creditAccountID"))
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
 		return
 	}
 
-	installments, err := c.installmentService.GetInstallmentsByCreditAccountID(uint(creditAccountID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	installments, err := c.installmentService.GetInstallmentsByCreditAccountID(uint(creditAccountID), query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -177,24 +192,38 @@ func (c *InstallmentController) GetInstallmentsByCreditAccountID(ctx *gin.Contex
 
 // GetOverdueInstallments godoc
 // @Summary Get overdue installments by credit account ID
-// @Description Retrieves all overdue installments for a specific credit account.
+// @Description Lists the overdue installments of a credit account.
 // @Tags Installments
 // @Produce json
-// @Param creditAccountID path int true "Credit Account ID"
-// @Success 200 {array} response.InstallmentResponse
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, number, due_date, amount (default due_date)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum amount"
+// @Param max_amount query number false "Maximum amount"
+// @Success 200 {object} response.PageResponse[response.InstallmentResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/credit-accounts/{creditAccountID}/installments/overdue [get]
+// @Router /api/v1/credit-accounts/{id}/installments/overdue [get]
 func (c *InstallmentController) GetOverdueInstallments(ctx *gin.Context) {
-	creditAccountID, err := strconv.Atoi(ctx.Param("creditAccountID"))
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
 		return
 	}
 
-	installments, err := c.installmentService.GetOverdueInstallments(uint(creditAccountID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	installments, err := c.installmentService.GetOverdueInstallments(uint(creditAccountID), query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/scheduler"
+	"github.com/gin-gonic/gin"
+)
+
+// JobController handles API requests related to scheduled jobs.
+type JobController struct {
+	scheduler *scheduler.Scheduler
+}
+
+// NewJobController creates a new JobController.
+func NewJobController(scheduler *scheduler.Scheduler) *JobController {
+	return &JobController{scheduler: scheduler}
+}
+
+// GetAllJobs godoc
+// @Summary Get all scheduled jobs
+// @Description Retrieves the scheduled jobs with their cron expression, state and next run.
+// @Tags Jobs
+// @Produce json
+// @Success 200 {array} response.ScheduledJobResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs [get]
+func (c *JobController) GetAllJobs(ctx *gin.Context) {
+	jobs, err := c.scheduler.Jobs()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, jobs)
+}
+
+// GetJobRuns godoc
+// @Summary Get the run history of a job
+// @Description Retrieves the latest runs of a scheduled job, one per establishment and execution.
+// @Tags Jobs
+// @Produce json
+// @Param name path string true "Job name"
+// @Param limit query int false "Maximum number of runs (default 50)"
+// @Success 200 {array} response.JobRunResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{name}/runs [get]
+func (c *JobController) GetJobRuns(ctx *gin.Context) {
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid limit"})
+		return
+	}
+
+	runs, err := c.scheduler.Runs(ctx.Param("name"), limit)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, runs)
+}
+
+// PauseJob godoc
+// @Summary Pause a job
+// @Description Stops the scheduled runs of a job on every replica. Manual triggers still work.
+// @Tags Jobs
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {object} response.ScheduledJobResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{name}/pause [put]
+func (c *JobController) PauseJob(ctx *gin.Context) {
+	job, err := c.scheduler.SetPaused(ctx.Param("name"), true)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, job)
+}
+
+// ResumeJob godoc
+// @Summary Resume a job
+// @Description Resumes the scheduled runs of a paused job.
+// @Tags Jobs
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {object} response.ScheduledJobResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{name}/resume [put]
+func (c *JobController) ResumeJob(ctx *gin.Context) {
+	job, err := c.scheduler.SetPaused(ctx.Param("name"), false)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, job)
+}
+
+// TriggerJob godoc
+// @Summary Trigger a job
+// @Description Runs a job right away for every establishment and returns the recorded runs.
+// @Tags Jobs
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {array} response.JobRunResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/jobs/{name}/trigger [post]
+func (c *JobController) TriggerJob(ctx *gin.Context) {
+	runs, err := c.scheduler.Trigger(ctx.Param("name"))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, runs)
+}
+
+func (c *JobController) handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, scheduler.ErrJobNotFound):
+		ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, scheduler.ErrJobRunning):
+		ctx.JSON(http.StatusConflict, response.ErrorResponse{Error: err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+	}
+}
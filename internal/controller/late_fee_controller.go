@@ -151,24 +151,38 @@ func (c *LateFeeController) DeleteLateFee(ctx *gin.Context) {
 
 // GetLateFeesByCreditAccountID godoc
 // @Summary Get late fees by credit account ID
-// @Description Retrieves all late fees for a specific credit account.
+// @Description Lists the late fees of a credit account.
 // @Tags LateFees
 // @Produce json
-// @Param creditAccountID path int true "Credit Account ID"
-// @Success 200 {array} response.LateFeeResponse
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, applied_date, amount (default applied_date)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum amount"
+// @Param max_amount query number false "Maximum amount"
+// @Success 200 {object} response.PageResponse[response.LateFeeResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/credit-accounts/{creditAccountID}/late-fees [get]
+// @Router /api/v1/credit-accounts/{id}/late-fees [get]
 func (c *LateFeeController) GetLateFeesByCreditAccountID(ctx *gin.Context) {
-	creditAccountID, err := strconv.Atoi(ctx.Param("creditAccountID"))
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
 		return
 	}
 
-	lateFees, err := c.lateFeeService.GetLateFeesByCreditAccountID(uint(creditAccountID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	lateFees, err := c.lateFeeService.GetLateFeesByCreditAccountID(uint(creditAccountID), query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"ApiRestFinance/internal/middleware"
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/service"
@@ -151,16 +152,27 @@ func (c *LateFeeRuleController) DeleteLateFeeRule(ctx *gin.Context) {
 
 // GetAllLateFeeRules godoc
 // @Summary Get all late fee rules
-// @Description Retrieves all late fee rules.
+// @Description Retrieves the late fee rules of the caller's establishment, or every rule for platform admins.
 // @Tags LateFeeRules
 // @Produce json
-// @Success 200 {array} response.LateFeeRuleResponse
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, name, days_overdue_min (default id)"
+// @Success 200 {object} response.PageResponse[response.LateFeeRuleResponse]
+// @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/late-fee-rules [get]
 func (c *LateFeeRuleController) GetAllLateFeeRules(ctx *gin.Context) {
-	lateFeeRules, err := c.lateFeeRuleService.GetAllLateFeeRules()
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	lateFeeRules, err := c.lateFeeRuleService.GetAllLateFeeRules(query, middleware.TenantFromContext(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -169,24 +181,34 @@ func (c *LateFeeRuleController) GetAllLateFeeRules(ctx *gin.Context) {
 
 // GetLateFeeRulesByEstablishmentID godoc
 // @Summary Get late fee rules by establishment ID
-// @Description Retrieves all late fee rules for a specific establishment.
+// @Description Lists the late fee rules of an establishment.
 // @Tags LateFeeRules
 // @Produce json
-// @Param establishmentID path int true "Establishment ID"
-// @Success 200 {array} response.LateFeeRuleResponse
+// @Param establishment_id path int true "Establishment ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, name, days_overdue_min (default id)"
+// @Success 200 {object} response.PageResponse[response.LateFeeRuleResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/establishments/{establishmentID}/late-fee-rules [get]
+// @Router /api/v1/establishments/{establishment_id}/late-fee-rules [get]
 func (c *LateFeeRuleController) GetLateFeeRulesByEstablishmentID(ctx *gin.Context) {
-	establishmentID, err := strconv.Atoi(ctx.Param("establishmentID"))
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
 		return
 	}
 
-	lateFeeRules, err := c.lateFeeRuleService.GetLateFeeRulesByEstablishmentID(uint(establishmentID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	lateFeeRules, err := c.lateFeeRuleService.GetLateFeeRulesByEstablishmentID(uint(establishmentID), query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
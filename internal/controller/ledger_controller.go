@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// LedgerController handles API requests related to the general ledger.
+type LedgerController struct {
+	ledgerService service.LedgerService
+}
+
+// NewLedgerController creates a new LedgerController.
+func NewLedgerController(ledgerService service.LedgerService) *LedgerController {
+	return &LedgerController{ledgerService: ledgerService}
+}
+
+// GetChart godoc
+// @Summary Get the chart of accounts of an establishment
+// @Description Lists the ledger accounts of an establishment, creating the default chart on first use.
+// @Tags Ledger
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Success 200 {array} response.LedgerAccountResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/ledger-accounts [get]
+func (c *LedgerController) GetChart(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	accounts, err := c.ledgerService.GetChart(uint(establishmentID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Establishment not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accounts)
+}
+
+// GetTrialBalance godoc
+// @Summary Get the trial balance of an establishment
+// @Description Sums the debits and credits posted to every ledger account of an establishment up to a date.
+// @Tags Ledger
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Param as_of query string false "Date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} response.TrialBalanceResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/trial-balance [get]
+func (c *LedgerController) GetTrialBalance(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	asOf := time.Now()
+	if value := ctx.Query("as_of"); value != "" {
+		if asOf, err = time.Parse("2006-01-02", value); err != nil {
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid 'as_of' date, expected YYYY-MM-DD"})
+			return
+		}
+	}
+
+	trialBalance, err := c.ledgerService.GetTrialBalance(uint(establishmentID), asOf)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Establishment not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, trialBalance)
+}
+
+// GetJournalEntries godoc
+// @Summary Get the journal entries of a credit account
+// @Description Lists the double-entry postings of every transaction of a credit account, oldest first.
+// @Tags Ledger
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, entry_date (default entry_date)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {object} response.PageResponse[response.JournalEntryResponse]
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/journal-entries [get]
+func (c *LedgerController) GetJournalEntries(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	entries, err := c.ledgerService.GetJournalEntries(uint(creditAccountID), query)
+	if err != nil {
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
+
+// ValidateCreditAccount godoc
+// @Summary Validate a credit account against the ledger
+// @Description Compares the balance and debt components stored on a credit account with its receivables in the ledger.
+// @Tags Ledger
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Success 200 {object} response.LedgerBalanceResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/ledger-balance [get]
+func (c *LedgerController) ValidateCreditAccount(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	balance, err := c.ledgerService.ValidateCreditAccount(uint(creditAccountID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, balance)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+)
+
+// listErrorStatus returns the status code of an error of a list endpoint. Unsupported sort
+// fields and malformed cursors are the caller's fault.
+func listErrorStatus(err error) int {
+	if errors.Is(err, repository.ErrInvalidListQuery) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// pageWith returns a page with the items given and the position and total of another page.
+func pageWith[T, R any](page *response.PageResponse[T], items []R) *response.PageResponse[R] {
+	return &response.PageResponse[R]{
+		Items:      items,
+		Total:      page.Total,
+		Limit:      page.Limit,
+		Offset:     page.Offset,
+		NextCursor: page.NextCursor,
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// PasswordResetController handles the forgot-password flow.
+type PasswordResetController struct {
+	passwordResetService service.PasswordResetService
+}
+
+// NewPasswordResetController creates a new PasswordResetController.
+func NewPasswordResetController(passwordResetService service.PasswordResetService) *PasswordResetController {
+	return &PasswordResetController{passwordResetService: passwordResetService}
+}
+
+// RequestPasswordReset godoc
+// @Summary      Forgot password
+// @Description  Emails a single-use link to reset the password, valid for one hour. The answer is the same whether the email has an account or not.
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Param        request  body      request.ForgotPasswordRequest  true  "Email of the account"
+// @Success      200  {object}  map[string]string
+// @Failure      400  {object}  map[string]string
+// @Failure      500  {object}  map[string]string
+// @Router       /api/v1/forgot-password [post]
+func (c *PasswordResetController) RequestPasswordReset(ctx *gin.Context) {
+	var req request.ForgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.passwordResetService.RequestPasswordReset(req); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "If the email has an account, a reset link was sent"})
+}
+
+// ConfirmPasswordReset godoc
+// @Summary      Reset forgotten password
+// @Description  Sets a new password with the token of a reset link and logs the user out of every session.
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Param        request  body      request.ConfirmPasswordResetRequest  true  "Token and new password"
+// @Success      200  {object}  map[string]string
+// @Failure      400  {object}  map[string]string
+// @Failure      500  {object}  map[string]string
+// @Router       /api/v1/forgot-password/confirm [post]
+func (c *PasswordResetController) ConfirmPasswordReset(ctx *gin.Context) {
+	var req request.ConfirmPasswordResetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.passwordResetService.ConfirmPasswordReset(req); err != nil {
+		if errors.Is(err, repository.ErrResetTokenInvalid) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Password reset successfully"})
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"ApiRestFinance/internal/middleware"
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/service"
@@ -25,16 +26,32 @@ func NewProductController(productService service.ProductService) *ProductControl
 
 // GetAllProducts godoc
 // @Summary Get all products
-// @Description Retrieve a list of all products.
+// @Description Retrieves the products of the caller's establishment. Clients browse the products of every establishment, and platform admins see them all.
 // @Tags Products
 // @Produce json
-// @Success 200 {array} response.ProductResponse
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, name, price, stock, created_at (default id)"
+// @Param status query string false "Product category"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum price"
+// @Param max_amount query number false "Maximum price"
+// @Success 200 {object} response.PageResponse[response.ProductResponse]
+// @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/products [get]
 func (c *ProductController) GetAllProducts(ctx *gin.Context) {
-	products, err := c.productService.GetAll()
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	products, err := c.productService.GetAll(query, middleware.TenantFromContext(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -77,7 +94,16 @@ func (c *ProductController) GetProductByID(ctx *gin.Context) {
 // @Tags Products
 // @Produce json
 // @Param establishment_id path uint true "Establishment ID"
-// @Success 200 {array} response.ProductResponse
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, name, price, stock, created_at (default id)"
+// @Param status query string false "Product category"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum price"
+// @Param max_amount query number false "Maximum price"
+// @Success 200 {object} response.PageResponse[response.ProductResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/establishments/{establishment_id}/products [get]
@@ -88,9 +114,15 @@ func (c *ProductController) GetProductsByEstablishmentID(ctx *gin.Context) {
 		return
 	}
 
-	products, err := c.productService.GetByEstablishmentID(uint(establishmentID))
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	products, err := c.productService.GetByEstablishmentID(uint(establishmentID), query)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
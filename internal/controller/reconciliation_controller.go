@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ReconciliationController handles API requests related to balance reconciliation.
+type ReconciliationController struct {
+	reconciliationService service.ReconciliationService
+}
+
+// NewReconciliationController creates a new ReconciliationController.
+func NewReconciliationController(reconciliationService service.ReconciliationService) *ReconciliationController {
+	return &ReconciliationController{reconciliationService: reconciliationService}
+}
+
+// Reconcile godoc
+// @Summary Reconcile the balances of an establishment
+// @Description Rebuilds the balance of every credit account of an establishment from its history and reports the accounts whose stored balance, transactions or ledger disagree. With dry_run=false, stored balances are corrected with an ADJUSTMENT.
+// @Tags Reconciliation
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Param dry_run query bool false "Only report the discrepancies (default true)"
+// @Success 200 {object} response.ReconciliationResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/reconcile [post]
+func (c *ReconciliationController) Reconcile(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil || establishmentID <= 0 {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	dryRun, err := strconv.ParseBool(ctx.DefaultQuery("dry_run", "true"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid 'dry_run' value, expected true or false"})
+		return
+	}
+
+	report, err := c.reconciliationService.Reconcile(uint(establishmentID), dryRun)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
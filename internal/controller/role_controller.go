@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// RoleController handles API requests related to roles and permissions.
+type RoleController struct {
+	roleService service.RoleService
+}
+
+// NewRoleController creates a new RoleController.
+func NewRoleController(roleService service.RoleService) *RoleController {
+	return &RoleController{roleService: roleService}
+}
+
+// GetAllRoles godoc
+// @Summary Get all roles
+// @Description Lists the roles of the permission model.
+// @Tags Roles
+// @Produce json
+// @Success 200 {array} response.RoleResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/roles [get]
+func (c *RoleController) GetAllRoles(ctx *gin.Context) {
+	roles, err := c.roleService.GetAllRoles()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, roles)
+}
+
+// AssignRoles godoc
+// @Summary Assign roles to a user
+// @Description Replaces the roles of a user and the establishment it works at, required for cashiers. The changes apply from the user's next access token.
+// @Tags Roles
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param roles body request.AssignRolesRequest true "Roles"
+// @Success 200 {object} response.UserResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/users/{id}/roles [put]
+func (c *RoleController) AssignRoles(ctx *gin.Context) {
+	userID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid user ID"})
+		return
+	}
+
+	var req request.AssignRolesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	user, err := c.roleService.AssignRoles(uint(userID), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "User or establishment not found"})
+		case errors.Is(err, repository.ErrUnknownRole), errors.Is(err, repository.ErrMissingEstablishment):
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, user)
+}
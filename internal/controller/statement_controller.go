@@ -0,0 +1,233 @@
+package controller
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ApiRestFinance/internal/export"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/service"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// StatementController handles API requests related to account statements.
+type StatementController struct {
+	statementService service.StatementService
+}
+
+// NewStatementController creates a new StatementController.
+func NewStatementController(statementService service.StatementService) *StatementController {
+	return &StatementController{statementService: statementService}
+}
+
+// CloseCycle godoc
+// @Summary Close the billing cycle of a credit account
+// @Description Closes the latest billing cycle of a SHORT_TERM credit account and stores its statement.
+// @Tags Statements
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Success 201 {object} response.StatementResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/statements [post]
+func (c *StatementController) CloseCycle(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	statement, err := c.statementService.CloseCycle(uint(creditAccountID))
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
+		case errors.Is(err, repository.ErrNotShortTerm):
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, repository.ErrNoCycleToClose):
+			ctx.JSON(http.StatusConflict, response.ErrorResponse{Error: err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, statement)
+}
+
+// CloseCyclesForEstablishment godoc
+// @Summary Close the billing cycles of an establishment
+// @Description Closes the latest billing cycle of every SHORT_TERM credit account within an establishment.
+// @Tags Statements
+// @Produce json
+// @Param establishment_id path int true "Establishment ID"
+// @Success 200 {array} response.StatementResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/establishments/{establishment_id}/statements/close [post]
+func (c *StatementController) CloseCyclesForEstablishment(ctx *gin.Context) {
+	establishmentID, err := strconv.Atoi(ctx.Param("establishment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid establishment ID"})
+		return
+	}
+
+	statements, err := c.statementService.CloseCyclesForEstablishment(uint(establishmentID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, statements)
+}
+
+// GetStatementsByCreditAccountID godoc
+// @Summary Get statements by credit account ID
+// @Description Lists the statements of a credit account, latest first. The date filters apply to the closing date.
+// @Tags Statements
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, period_end, closing_balance (default -period_end)"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum closing balance"
+// @Param max_amount query number false "Maximum closing balance"
+// @Success 200 {object} response.PageResponse[response.StatementResponse]
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/statements [get]
+func (c *StatementController) GetStatementsByCreditAccountID(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	var query request.ListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	statements, err := c.statementService.GetStatementsByCreditAccountID(uint(creditAccountID), query)
+	if err != nil {
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, statements)
+}
+
+// GetStatement godoc
+// @Summary Get a statement
+// @Description Retrieves a statement of a credit account by its ID.
+// @Tags Statements
+// @Produce json
+// @Param id path int true "Credit Account ID"
+// @Param statement_id path int true "Statement ID"
+// @Success 200 {object} response.StatementResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/statements/{statement_id} [get]
+func (c *StatementController) GetStatement(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	statementID, err := strconv.Atoi(ctx.Param("statement_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid statement ID"})
+		return
+	}
+
+	statement, err := c.statementService.GetStatement(uint(creditAccountID), uint(statementID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Statement not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, statement)
+}
+
+// ExportStatement godoc
+// @Summary Export an account statement
+// @Description Renders the statement of a credit account for a date range as PDF (default) or CSV.
+// @Tags Statements
+// @Produce application/pdf
+// @Produce text/csv
+// @Param id path int true "Credit Account ID"
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Param format query string false "Export format" Enums(pdf, csv)
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/statements/export [get]
+func (c *StatementController) ExportStatement(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid credit account ID"})
+		return
+	}
+
+	from, err := time.Parse("2006-01-02", ctx.Query("from"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid start date, expected YYYY-MM-DD"})
+		return
+	}
+	to, err := time.Parse("2006-01-02", ctx.Query("to"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid end date, expected YYYY-MM-DD"})
+		return
+	}
+
+	format := export.Format(ctx.DefaultQuery("format", string(export.PDF)))
+	if format != export.PDF && format != export.CSV {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid format, expected pdf or csv"})
+		return
+	}
+
+	statement, err := c.statementService.ExportStatement(uint(creditAccountID), from, to)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit account not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var buf bytes.Buffer
+	if format == export.CSV {
+		err = export.WriteCSV(&buf, *statement)
+	} else {
+		err = export.WritePDF(&buf, *statement)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", statement.FileName(format)))
+	ctx.Data(http.StatusOK, format.ContentType(), buf.Bytes())
+}
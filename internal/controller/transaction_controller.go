@@ -7,6 +7,7 @@ import (
 
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
 	"ApiRestFinance/internal/service"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
@@ -30,9 +31,12 @@ func NewTransactionController(transactionService service.TransactionService) *Tr
 // @Tags Transactions
 // @Accept  json
 // @Produce  json
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
 // @Param transaction body request.CreateTransactionRequest true "Transaction Data"
 // @Success 201 {object} response.TransactionResponse
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/v1/transactions [post]
 func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
@@ -82,99 +86,252 @@ func (c *TransactionController) GetTransactionByID(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 }
 
-// UpdateTransaction godoc
-// @Summary Update Transaction
-// @Description Update a transaction by its ID.
+// ReverseTransaction godoc
+// @Summary Reverse Transaction
+// @Description Cancel a posted transaction with a REVERSAL that references it. Transactions are never edited or deleted.
 // @Tags Transactions
 // @Accept  json
 // @Produce  json
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
 // @Param id path int true "Transaction ID"
-// @Param transaction body request.UpdateTransactionRequest true "Transaction Data"
-// @Success 200 {object} response.TransactionResponse
+// @Param reversal body request.ReverseTransactionRequest true "Reversal Data"
+// @Success 201 {object} response.TransactionResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/transactions/{id} [put]
-func (c *TransactionController) UpdateTransaction(ctx *gin.Context) {
+// @Router /api/v1/transactions/{id}/reverse [post]
+func (c *TransactionController) ReverseTransaction(ctx *gin.Context) {
 	transactionID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid Transaction ID"})
 		return
 	}
 
-	var req request.UpdateTransactionRequest
+	var req request.ReverseTransactionRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
 		return
 	}
 
-	resp, err := c.transactionService.UpdateTransaction(uint(transactionID), req)
+	resp, err := c.transactionService.ReverseTransaction(uint(transactionID), req)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
 			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Transaction not found"})
-			return
+		case errors.Is(err, repository.ErrAlreadyReversed):
+			ctx.JSON(http.StatusConflict, response.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, repository.ErrNotReversible):
+			ctx.JSON(http.StatusUnprocessableEntity, response.ErrorResponse{Error: err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
 		}
-		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
 		return
 	}
-	ctx.JSON(http.StatusOK, resp)
+	ctx.JSON(http.StatusCreated, resp)
 }
 
-// DeleteTransaction godoc
-// @Summary Delete Transaction
-// @Description Delete a transaction by its ID.
+// CreateAdjustment godoc
+// @Summary Create Adjustment
+// @Description Post a manual ADJUSTMENT of the balance of a credit account. Positive amounts increase the balance.
 // @Tags Transactions
 // @Accept  json
 // @Produce  json
-// @Param id path int true "Transaction ID"
-// @Success 204 {object} response.TransactionResponse
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
+// @Param id path int true "Credit Account ID"
+// @Param adjustment body request.CreateAdjustmentRequest true "Adjustment Data"
+// @Success 201 {object} response.TransactionResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/transactions/{id} [delete]
-func (c *TransactionController) DeleteTransaction(ctx *gin.Context) {
-	transactionID, err := strconv.Atoi(ctx.Param("id"))
+// @Router /api/v1/credit-accounts/{id}/adjustments [post]
+func (c *TransactionController) CreateAdjustment(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid Transaction ID"})
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid Credit Account ID"})
 		return
 	}
 
-	if err := c.transactionService.DeleteTransaction(uint(transactionID)); err != nil {
+	var req request.CreateAdjustmentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := c.transactionService.CreateAdjustment(uint(creditAccountID), req)
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Transaction not found"})
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit Account not found"})
 			return
 		}
 		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
 		return
 	}
-	ctx.Status(http.StatusNoContent)
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+// WriteOff godoc
+// @Summary Write Off Credit Account
+// @Description Charge the whole outstanding balance of a credit account to bad debt and block the account.
+// @Tags Transactions
+// @Accept  json
+// @Produce  json
+// @Param Idempotency-Key header string true "Unique key that makes retries safe"
+// @Param id path int true "Credit Account ID"
+// @Param writeOff body request.WriteOffRequest true "Write-off Data"
+// @Success 201 {object} response.TransactionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 422 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/write-off [post]
+func (c *TransactionController) WriteOff(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid Credit Account ID"})
+		return
+	}
+
+	var req request.WriteOffRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := c.transactionService.WriteOff(uint(creditAccountID), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit Account not found"})
+		case errors.Is(err, repository.ErrNothingToWriteOff):
+			ctx.JSON(http.StatusUnprocessableEntity, response.ErrorResponse{Error: err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+	ctx.JSON(http.StatusCreated, resp)
 }
 
 // GetTransactionsByCreditAccountID godoc
 // @Summary Get Transaction by Credit Account ID
-// @Description Get all transactions for a specific credit account.
+// @Description Lists the transactions of a credit account.
 // @Tags Transactions
 // @Accept  json
 // @Produce  json
-// @Param creditAccountID path int true "Credit Account ID"
-// @Success 200 {array} response.TransactionResponse
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, transaction_date, amount (default transaction_date)"
+// @Param status query string false "Transaction type"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum amount"
+// @Param max_amount query number false "Maximum amount"
+// @Param description query string false "Text contained in the description"
+// @Success 200 {object} response.PageResponse[response.TransactionResponse]
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /api/v1/credit-accounts/{creditAccountID}/transactions [get]
+// @Router /api/v1/credit-accounts/{id}/transactions [get]
 func (c *TransactionController) GetTransactionsByCreditAccountID(ctx *gin.Context) {
-	creditAccountID, err := strconv.Atoi(ctx.Param("creditAccountID"))
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid Credit Account ID"})
 		return
 	}
 
-	resp, err := c.transactionService.GetTransactionsByCreditAccountID(uint(creditAccountID))
+	var query request.TransactionSearchQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := c.transactionService.GetTransactionsByCreditAccountID(uint(creditAccountID), query)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Credit Account not found"})
 			return
 		}
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetTransactionHistory godoc
+// @Summary Search Transaction History
+// @Description Searches the history of a credit account. Each entry carries the running balance after it; the response adds the balances before and at the end of the period and the totals per transaction type of every matching entry. Amounts are compared by magnitude.
+// @Tags Transactions
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Credit Account ID"
+// @Param limit query int false "Items per page, up to 200 (default 50)"
+// @Param offset query int false "Items to skip"
+// @Param cursor query string false "Next cursor of the previous page, replaces offset"
+// @Param sort query string false "Sort field, prefixed with - for descending order: id, transaction_date, amount (default transaction_date)"
+// @Param status query string false "Transaction type"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param min_amount query number false "Minimum amount"
+// @Param max_amount query number false "Maximum amount"
+// @Param description query string false "Text contained in the description"
+// @Success 200 {object} response.TransactionHistoryResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/credit-accounts/{id}/history [get]
+func (c *TransactionController) GetTransactionHistory(ctx *gin.Context) {
+	creditAccountID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid Credit Account ID"})
+		return
+	}
+
+	var query request.TransactionSearchQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := c.transactionService.SearchTransactionHistory(uint(creditAccountID), query)
+	if err != nil {
+		ctx.JSON(listErrorStatus(err), response.ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPaymentAllocations godoc
+// @Summary Get Payment Allocations
+// @Description Get how a payment was split across fees, penalty interest, interest, principal and installments.
+// @Tags Transactions
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Transaction ID"
+// @Success 200 {array} response.PaymentAllocationResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/v1/transactions/{id}/allocations [get]
+func (c *TransactionController) GetPaymentAllocations(ctx *gin.Context) {
+	transactionID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{Error: "Invalid Transaction ID"})
+		return
+	}
+
+	resp, err := c.transactionService.GetPaymentAllocations(uint(transactionID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{Error: "Transaction not found"})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -0,0 +1,31 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// WriteCSV writes the statement movements as CSV, framed by the opening and closing balances.
+func WriteCSV(w io.Writer, statement AccountStatement) error {
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{
+		{"date", "type", "description", "amount", "balance"},
+		{statement.From.Format(dateLayout), "OPENING_BALANCE", "Opening balance", "", statement.Summary.OpeningBalance.String()},
+	}
+	for _, movement := range statement.Movements {
+		rows = append(rows, []string{
+			movement.TransactionDate.Format(dateLayout),
+			string(movement.TransactionType),
+			movement.Description,
+			movement.Amount.String(),
+			movement.Balance.String(),
+		})
+	}
+	rows = append(rows, []string{statement.To.Format(dateLayout), "CLOSING_BALANCE", "Closing balance", "", statement.Summary.ClosingBalance.String()})
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
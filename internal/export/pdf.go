@@ -0,0 +1,122 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"ApiRestFinance/internal/model/money"
+	"github.com/go-pdf/fpdf"
+)
+
+// WritePDF renders the statement as an A4 PDF document.
+func WritePDF(w io.Writer, statement AccountStatement) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	tr := pdf.UnicodeTranslatorFromDescriptor("") // Core fonts use cp1252, translate accents
+	pdf.AddPage()
+
+	// Establishment header
+	establishment := statement.Establishment
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(0, 7, tr(establishment.Name), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, tr("RUC: "+establishment.RUC), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr(establishment.Address), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr("Tel.: "+establishment.Phone), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 7, tr("Estado de cuenta"), "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, fmt.Sprintf("Del %s al %s", statement.From.Format(dateLayout), statement.To.Format(dateLayout)), "", 1, "C", false, 0, "")
+	pdf.Ln(3)
+
+	// Client and account data
+	client := statement.Client
+	account := statement.CreditAccount
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(0, 5, "Cliente", "B", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(90, 5, tr("Nombre: "+client.User.Name), "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr("Cuenta N.º: "+fmt.Sprint(account.ID)), "", 1, "L", false, 0, "")
+	pdf.CellFormat(90, 5, tr("Correo: "+client.Email), "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr("Línea de crédito: S/ "+account.CreditLimit.String()), "", 1, "L", false, 0, "")
+	pdf.CellFormat(90, 5, tr("Teléfono: "+client.Phone), "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr(fmt.Sprintf("Tasa: %.2f%% %s", account.InterestRate, account.InterestType)), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	// Movements
+	widths := []float64{22, 38, 70, 25, 25}
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.SetFillColor(230, 230, 230)
+	for i, header := range []string{"Fecha", "Tipo", tr("Descripción"), "Monto", "Saldo"} {
+		align := "L"
+		if i >= 3 {
+			align = "R"
+		}
+		pdf.CellFormat(widths[i], 6, header, "1", 0, align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 8)
+	movementRow(pdf, widths, statement.From.Format(dateLayout), "", "Saldo anterior", "", statement.Summary.OpeningBalance.String())
+	for _, movement := range statement.Movements {
+		movementRow(pdf, widths,
+			movement.TransactionDate.Format(dateLayout),
+			string(movement.TransactionType),
+			tr(truncate(movement.Description, 48)),
+			movement.Amount.String(),
+			movement.Balance.String())
+	}
+	pdf.Ln(4)
+
+	// Totals
+	summary := statement.Summary
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(0, 5, "Resumen", "B", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	for _, total := range []struct {
+		label  string
+		amount money.Money
+	}{
+		{"Saldo anterior", summary.OpeningBalance},
+		{"Compras", summary.Purchases},
+		{"Pagos", summary.Payments.Neg()},
+		{"Intereses", summary.Interest},
+		{"Moras", summary.Fees},
+		{"Otros movimientos", summary.Adjustments},
+	} {
+		pdf.CellFormat(130, 5, total.label, "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 5, "S/ "+total.amount.String(), "", 1, "R", false, 0, "")
+	}
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(130, 6, "Saldo final", "T", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 6, "S/ "+summary.ClosingBalance.String(), "T", 1, "R", false, 0, "")
+	pdf.Ln(6)
+
+	pdf.SetFont("Helvetica", "I", 7)
+	pdf.CellFormat(0, 4, "Generado el "+statement.GeneratedAt.Format("02/01/2006 15:04"), "", 1, "R", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+func movementRow(pdf *fpdf.Fpdf, widths []float64, cells ...string) {
+	for i, cell := range cells {
+		align := "L"
+		if i >= 3 {
+			align = "R"
+		}
+		pdf.CellFormat(widths[i], 5, cell, "1", 0, align, false, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+// truncate shortens s to at most n runes so a description fits in its column.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,44 @@
+// Package export renders account statements ("estado de cuenta") as CSV and PDF.
+package export
+
+import (
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+)
+
+// Format is an export file format.
+type Format string
+
+const (
+	CSV Format = "csv"
+	PDF Format = "pdf"
+)
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	if f == PDF {
+		return "application/pdf"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// AccountStatement holds everything printed on an account statement for a date range.
+type AccountStatement struct {
+	Establishment entities.Establishment
+	Client        entities.Client
+	CreditAccount entities.CreditAccount
+	From          time.Time
+	To            time.Time
+	GeneratedAt   time.Time
+	Summary       entities.Statement // Totals of the range, OpeningBalance is the balance before From
+	Movements     []entities.CreditAccountHistory
+}
+
+// FileName returns the suggested download name, e.g. "statement-12-20260101-20260131.pdf".
+func (s AccountStatement) FileName(format Format) string {
+	return fmt.Sprintf("statement-%d-%s-%s.%s", s.CreditAccount.ID, s.From.Format("20060102"), s.To.Format("20060102"), format)
+}
+
+const dateLayout = "02/01/2006"
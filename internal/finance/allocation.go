@@ -0,0 +1,157 @@
+package finance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// DefaultAllocationOrder is the waterfall used when an establishment does not configure one.
+var DefaultAllocationOrder = []enums.AllocationComponent{
+	enums.FeesComponent,
+	enums.PenaltyInterestComponent,
+	enums.InterestComponent,
+	enums.PrincipalComponent,
+}
+
+// ParseAllocationOrder reads a comma-separated allocation order. Components left out are
+// appended in the default order so a payment can always settle the whole balance.
+func ParseAllocationOrder(value string) ([]enums.AllocationComponent, error) {
+	var order []enums.AllocationComponent
+	seen := make(map[enums.AllocationComponent]bool)
+	for _, part := range strings.Split(value, ",") {
+		component := enums.AllocationComponent(strings.TrimSpace(part))
+		if component == "" {
+			continue
+		}
+		if !isAllocationComponent(component) {
+			return nil, fmt.Errorf("invalid allocation component %q", component)
+		}
+		if seen[component] {
+			return nil, fmt.Errorf("duplicated allocation component %q", component)
+		}
+		seen[component] = true
+		order = append(order, component)
+	}
+
+	for _, component := range DefaultAllocationOrder {
+		if !seen[component] {
+			order = append(order, component)
+		}
+	}
+	return order, nil
+}
+
+// FormatAllocationOrder is the inverse of ParseAllocationOrder.
+func FormatAllocationOrder(order []enums.AllocationComponent) string {
+	parts := make([]string, len(order))
+	for i, component := range order {
+		parts[i] = string(component)
+	}
+	return strings.Join(parts, ",")
+}
+
+func isAllocationComponent(component enums.AllocationComponent) bool {
+	for _, known := range DefaultAllocationOrder {
+		if component == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Balances splits what a client owes into the components a payment is allocated to.
+type Balances map[enums.AllocationComponent]money.Money
+
+// BalancesOf returns the components of the account balance. Principal is whatever part of
+// the current balance is not fees or interest, which also covers balances posted before
+// the components were tracked.
+func BalancesOf(account entities.CreditAccount) Balances {
+	return Balances{
+		enums.FeesComponent:            account.FeesBalance,
+		enums.PenaltyInterestComponent: account.PenaltyInterestBalance,
+		enums.InterestComponent:        account.InterestBalance,
+		enums.PrincipalComponent:       account.CurrentBalance - account.FeesBalance - account.PenaltyInterestBalance - account.InterestBalance,
+	}
+}
+
+// Allocate distributes a payment over the balances following order. Whatever cannot be
+// applied (an overpayment) is returned as unapplied.
+func Allocate(amount money.Money, balances Balances, order []enums.AllocationComponent) (allocation Balances, unapplied money.Money) {
+	allocation = Balances{}
+	remaining := amount
+	for _, component := range order {
+		if !remaining.IsPositive() {
+			break
+		}
+		applied := remaining.Min(balances[component].Max(money.Zero))
+		if applied.IsPositive() {
+			allocation[component] = applied
+			remaining -= applied
+		}
+	}
+	return allocation, remaining
+}
+
+// InstallmentPayment is the part of a payment applied to one installment.
+type InstallmentPayment struct {
+	Index     int // Position in the installments slice
+	Interest  money.Money
+	Principal money.Money
+}
+
+// ApplyToInstallments settles installments oldest first with the interest and principal
+// allocated from a payment, updating their paid amounts and statuses in place. Amounts
+// that exceed the installments (e.g. paying ahead of the schedule) are left unapplied.
+func ApplyToInstallments(installments []entities.Installment, interest, principal money.Money, paidAt time.Time) []InstallmentPayment {
+	var payments []InstallmentPayment
+	for i := range installments {
+		if !interest.IsPositive() && !principal.IsPositive() {
+			break
+		}
+		installment := &installments[i]
+		if installment.Status == enums.Paid {
+			continue
+		}
+
+		payment := InstallmentPayment{Index: i}
+		payment.Interest = interest.Min((installment.Interest - installment.InterestPaid).Max(money.Zero))
+		payment.Principal = principal.Min((installment.Principal - installment.PrincipalPaid).Max(money.Zero))
+		if payment.Interest.IsZero() && payment.Principal.IsZero() {
+			continue
+		}
+
+		interest -= payment.Interest
+		principal -= payment.Principal
+		installment.InterestPaid += payment.Interest
+		installment.PrincipalPaid += payment.Principal
+		if installment.InterestPaid >= installment.Interest && installment.PrincipalPaid >= installment.Principal {
+			installment.Status = enums.Paid
+			installment.PaidAt = &paidAt
+		} else {
+			installment.Status = enums.PartiallyPaid
+		}
+		payments = append(payments, payment)
+	}
+	return payments
+}
+
+// RevertInstallmentPayment takes back the interest and principal a reversed payment had applied
+// to an installment and puts the installment back into the status it had before.
+func RevertInstallmentPayment(installment *entities.Installment, interest, principal money.Money, asOf time.Time) {
+	installment.InterestPaid = (installment.InterestPaid - interest).Max(money.Zero)
+	installment.PrincipalPaid = (installment.PrincipalPaid - principal).Max(money.Zero)
+	installment.PaidAt = nil
+	switch {
+	case installment.InterestPaid.IsPositive() || installment.PrincipalPaid.IsPositive():
+		installment.Status = enums.PartiallyPaid
+	case installment.DueDate.Before(DateOf(asOf)):
+		installment.Status = enums.Overdue
+	default:
+		installment.Status = enums.Pending
+	}
+}
@@ -0,0 +1,140 @@
+package finance
+
+import (
+	"math"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// ScheduleTerms describes the loan an amortization schedule is built for.
+type ScheduleTerms struct {
+	Principal      money.Money
+	Term           int // Number of monthly installments
+	Method         enums.AmortizationMethod
+	MonthlyDueDate int       // Day of the month (1-31)
+	Start          time.Time // Disbursement date, the first installment falls due the following month
+	FirstNumber    int       // Number of the first generated installment (1 for a new schedule)
+	GracePeriods   int       // Due dates before amortization starts
+	GraceType      enums.GraceType
+	Interest       InterestTerms
+}
+
+// ScheduleTermsFor returns the schedule terms of a credit account for the given principal.
+func ScheduleTermsFor(account entities.CreditAccount, principal money.Money, start time.Time) ScheduleTerms {
+	return ScheduleTerms{
+		Principal:      principal,
+		Term:           account.Term,
+		Method:         account.AmortizationMethod,
+		MonthlyDueDate: account.MonthlyDueDate,
+		Start:          start,
+		FirstNumber:    1,
+		GracePeriods:   GracePeriodsRemaining(account, start),
+		GraceType:      account.GraceType,
+		Interest:       TermsFor(account),
+	}
+}
+
+// BuildSchedule generates the pending installments of a loan. Every period uses
+// the 30-day effective rate (TEM) so that French payments stay constant; the last
+// installment absorbs rounding so the principal column adds up to the loan.
+//
+// During the grace periods no principal is repaid: under partial grace an
+// interest-only installment is produced, under total grace the interest is
+// capitalized and no installment is due. Term installments follow the grace.
+func BuildSchedule(terms ScheduleTerms) []entities.Installment {
+	if terms.Term <= 0 || !terms.Principal.IsPositive() {
+		return nil
+	}
+
+	rate := terms.Interest.MonthlyEffectiveRate()
+	firstNumber := terms.FirstNumber
+	if firstNumber <= 0 {
+		firstNumber = 1
+	}
+
+	installments := make([]entities.Installment, 0, terms.GracePeriods+terms.Term)
+	balance := terms.Principal
+	for g := 1; g <= terms.GracePeriods; g++ {
+		interest := balance.MulRate(rate)
+		if terms.GraceType == enums.TotalGrace {
+			balance += interest
+			continue
+		}
+		installments = append(installments, entities.Installment{
+			Number:    firstNumber + len(installments),
+			DueDate:   DueDate(terms.Start, terms.MonthlyDueDate, g),
+			Amount:    interest,
+			Principal: money.Zero,
+			Interest:  interest,
+			Balance:   balance,
+			Status:    enums.Pending,
+		})
+	}
+
+	principalParts := balance.Split(terms.Term)
+	payment := frenchPayment(balance, rate, terms.Term)
+	for k := 1; k <= terms.Term; k++ {
+		interest := balance.MulRate(rate)
+
+		var principal money.Money
+		switch {
+		case k == terms.Term:
+			principal = balance
+		case terms.Method == enums.German:
+			principal = principalParts[k-1]
+		case terms.Method == enums.Bullet:
+			principal = money.Zero
+		default: // French
+			principal = (payment - interest).Max(money.Zero).Min(balance)
+		}
+		balance -= principal
+
+		installments = append(installments, entities.Installment{
+			Number:    firstNumber + len(installments),
+			DueDate:   DueDate(terms.Start, terms.MonthlyDueDate, terms.GracePeriods+k),
+			Amount:    principal + interest,
+			Principal: principal,
+			Interest:  interest,
+			Balance:   balance,
+			Status:    enums.Pending,
+		})
+	}
+
+	return installments
+}
+
+// frenchPayment returns the constant installment P*i/(1-(1+i)^-n).
+func frenchPayment(principal money.Money, rate float64, term int) money.Money {
+	if rate <= 0 {
+		return principal.Div(int64(term))
+	}
+	return principal.MulRate(rate / (1 - math.Pow(1+rate, -float64(term))))
+}
+
+// DueDate returns the due date of the n-th monthly installment after start. Days
+// that do not exist in a month (e.g. the 31st) fall on the last day of that month.
+func DueDate(start time.Time, monthlyDueDate int, n int) time.Time {
+	y, m, _ := DateOf(start).Date()
+	first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := monthlyDueDate
+	if day < 1 {
+		day = 1
+	}
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// ScheduledInterest adds up the interest component of the given installments.
+func ScheduledInterest(installments []entities.Installment) money.Money {
+	var interest money.Money
+	for _, installment := range installments {
+		interest += installment.Interest
+	}
+	return interest
+}
@@ -0,0 +1,70 @@
+package finance
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// GraceEnd returns the last due date covered by the grace period of an account. The
+// grace period starts when the account is opened and spans GracePeriod due dates.
+// It returns the zero time when the account has no grace period.
+func GraceEnd(account entities.CreditAccount) time.Time {
+	if account.GracePeriod <= 0 {
+		return time.Time{}
+	}
+	return DueDate(account.CreatedAt, account.MonthlyDueDate, account.GracePeriod)
+}
+
+// InGracePeriod reports whether t falls inside the grace period of the account.
+func InGracePeriod(account entities.CreditAccount, t time.Time) bool {
+	if account.GracePeriod <= 0 {
+		return false
+	}
+	return !DateOf(t).After(GraceEnd(account))
+}
+
+// GracePeriodsRemaining returns how many monthly due dates after start are still
+// inside the grace period, used when a schedule is rebuilt mid-way.
+func GracePeriodsRemaining(account entities.CreditAccount, start time.Time) int {
+	end := GraceEnd(account)
+	remaining := 0
+	for n := 1; n <= account.GracePeriod; n++ {
+		if DueDate(start, account.MonthlyDueDate, n).After(end) {
+			break
+		}
+		remaining++
+	}
+	return remaining
+}
+
+// CapitalizesInterest reports whether interest is added to the principal during
+// the grace period (total grace) instead of being charged (partial grace).
+func CapitalizesInterest(account entities.CreditAccount) bool {
+	return account.GraceType == enums.TotalGrace
+}
+
+// CapitalizedGraceInterest returns the interest a total grace period adds to the principal of a
+// LONG_TERM account at the grace due dates after from and up to asOf. Each period compounds on the
+// previous ones exactly as BuildSchedule does, so the principal receivable keeps matching the
+// principal of the schedule.
+func CapitalizedGraceInterest(account entities.CreditAccount, principal money.Money, from, asOf time.Time) money.Money {
+	if account.CreditType != enums.LongTerm || !CapitalizesInterest(account) {
+		return money.Zero
+	}
+
+	rate := TermsFor(account).MonthlyEffectiveRate()
+	interest := money.Zero
+	for n := 1; n <= account.GracePeriod; n++ {
+		dueDate := DueDate(account.CreatedAt, account.MonthlyDueDate, n)
+		if dueDate.After(DateOf(asOf)) {
+			break
+		}
+		if dueDate.After(DateOf(from)) {
+			interest += (principal + interest).MulRate(rate)
+		}
+	}
+	return interest
+}
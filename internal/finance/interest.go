@@ -0,0 +1,125 @@
+// Package finance holds the financial math shared by repositories and services.
+package finance
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// InterestTerms describes how an annual rate is turned into interest for a period.
+type InterestTerms struct {
+	AnnualRate   float64 // Annual rate as a percentage (e.g. 45.5 for 45.5%)
+	InterestType enums.InterestType
+	DayCount     enums.DayCountConvention
+	Compounding  enums.CompoundingFrequency
+}
+
+// TermsFor returns the interest terms configured on a credit account.
+func TermsFor(account entities.CreditAccount) InterestTerms {
+	return InterestTerms{
+		AnnualRate:   account.InterestRate,
+		InterestType: account.InterestType,
+		DayCount:     account.DayCount,
+		Compounding:  account.Compounding,
+	}
+}
+
+// DaysInYear returns the year basis of the day-count convention (360 by default).
+func (t InterestTerms) DaysInYear() float64 {
+	if t.DayCount == enums.Actual365 {
+		return 365
+	}
+	return 360
+}
+
+// periodsPerYear returns how many times a nominal rate is capitalized per year.
+func (t InterestTerms) periodsPerYear() float64 {
+	if t.Compounding == enums.DailyCompounding {
+		return t.DaysInYear()
+	}
+	return 12
+}
+
+// EffectiveAnnualRate returns the TEA as a fraction. Effective rates are returned
+// as is, nominal rates (TNA) are capitalized with the configured frequency.
+func (t InterestTerms) EffectiveAnnualRate() float64 {
+	rate := t.AnnualRate / 100
+	if t.InterestType == enums.Nominal {
+		m := t.periodsPerYear()
+		return math.Pow(1+rate/m, m) - 1
+	}
+	return rate
+}
+
+// MonthlyEffectiveRate returns the TEM (30-day effective rate) as a fraction.
+func (t InterestTerms) MonthlyEffectiveRate() float64 {
+	return t.PeriodRate(30)
+}
+
+// PeriodRate returns the effective rate for a period of the given number of days.
+func (t InterestTerms) PeriodRate(days int) float64 {
+	if days <= 0 || t.AnnualRate <= 0 {
+		return 0
+	}
+	rate := t.AnnualRate / 100
+	basis := t.DaysInYear()
+	if t.InterestType == enums.Nominal {
+		m := t.periodsPerYear()
+		return math.Pow(1+rate/m, float64(days)*m/basis) - 1
+	}
+	return math.Pow(1+rate, float64(days)/basis) - 1
+}
+
+// Interest returns the interest earned by principal over the given number of days.
+func (t InterestTerms) Interest(principal money.Money, days int) money.Money {
+	if !principal.IsPositive() {
+		return money.Zero
+	}
+	return principal.MulRate(t.PeriodRate(days))
+}
+
+// String describes the terms, e.g. "TEA 45.50% ACT_360".
+func (t InterestTerms) String() string {
+	label := "TEA"
+	if t.InterestType == enums.Nominal {
+		label = fmt.Sprintf("TNA cap. %s", t.Compounding)
+	}
+	return fmt.Sprintf("%s %.2f%% %s", label, t.AnnualRate, t.DayCount)
+}
+
+// TEAToTEM converts an effective annual rate (percentage) to the 30-day
+// effective monthly rate (percentage) under the given day-count convention.
+func TEAToTEM(tea float64, dayCount enums.DayCountConvention) float64 {
+	terms := InterestTerms{AnnualRate: tea, InterestType: enums.Effective, DayCount: dayCount}
+	return terms.MonthlyEffectiveRate() * 100
+}
+
+// DateOf truncates t to midnight UTC so day counts do not depend on the time of day.
+func DateOf(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// DaysBetween returns the number of calendar days from start to end.
+func DaysBetween(start, end time.Time) int {
+	return int(DateOf(end).Sub(DateOf(start)).Hours() / 24)
+}
+
+// AccrualDue reports whether a full month has elapsed since the last interest accrual.
+func AccrualDue(account entities.CreditAccount, asOf time.Time) bool {
+	nextAccrual := DateOf(account.LastInterestAccrualDate).AddDate(0, 1, 0)
+	return !DateOf(asOf).Before(nextAccrual)
+}
+
+// AccruedInterest returns the interest on the current balance between the last
+// accrual date and asOf. The result only depends on the account and the dates,
+// so running it twice for the same period yields the same amount.
+func AccruedInterest(account entities.CreditAccount, asOf time.Time) money.Money {
+	days := DaysBetween(account.LastInterestAccrualDate, asOf)
+	return TermsFor(account).Interest(account.CurrentBalance, days)
+}
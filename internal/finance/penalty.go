@@ -0,0 +1,41 @@
+package finance
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// PenaltyTermsFor returns the terms of the penalty interest of a credit account: its penalty
+// rate under the conventions of its regular rate.
+func PenaltyTermsFor(account entities.CreditAccount) InterestTerms {
+	terms := TermsFor(account)
+	terms.AnnualRate = account.PenaltyInterestRate
+	return terms
+}
+
+// PenaltyInterest returns the penalty interest earned up to asOf by the unpaid part of the
+// installments past their due date, from their due date or from the last penalty accrual,
+// whichever is later. Nothing is charged without a penalty rate or inside the grace period.
+func PenaltyInterest(account entities.CreditAccount, installments []entities.Installment, asOf time.Time) money.Money {
+	if account.PenaltyInterestRate <= 0 || InGracePeriod(account, asOf) {
+		return money.Zero
+	}
+
+	terms := PenaltyTermsFor(account)
+	penalty := money.Zero
+	for _, installment := range installments {
+		if installment.Status == enums.Paid {
+			continue
+		}
+		from := DateOf(installment.DueDate)
+		if account.LastPenaltyAccrualDate != nil && account.LastPenaltyAccrualDate.After(from) {
+			from = DateOf(*account.LastPenaltyAccrualDate)
+		}
+		unpaid := installment.Amount - installment.InterestPaid - installment.PrincipalPaid
+		penalty += terms.Interest(unpaid, DaysBetween(from, asOf))
+	}
+	return penalty
+}
@@ -0,0 +1,47 @@
+package finance
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/money"
+)
+
+// InterestToDate returns the interest earned by the outstanding principal of a LONG_TERM
+// account from the start of the period of its next installment up to asOf. It is what a
+// prepayment owes on top of the principal; the rest of the scheduled interest is rebated.
+// The result never exceeds the interest the next installment charges.
+func InterestToDate(account entities.CreditAccount, principal money.Money, next entities.Installment, asOf time.Time) money.Money {
+	periodStart := DateOf(next.DueDate).AddDate(0, -1, 0)
+	if opened := DateOf(account.CreatedAt); opened.After(periodStart) {
+		periodStart = opened
+	}
+	days := DaysBetween(periodStart, asOf)
+	if days <= 0 {
+		return money.Zero
+	}
+	return TermsFor(account).Interest(principal, days).Min(next.Interest)
+}
+
+// TermForPayment returns the shortest term, up to maxTerm, whose first amortizing installment
+// does not exceed payment. It shortens a schedule after a prepayment while keeping the
+// installment the client already pays.
+func TermForPayment(terms ScheduleTerms, payment money.Money, maxTerm int) int {
+	for n := 1; n < maxTerm; n++ {
+		terms.Term = n
+		if AmortizingInstallment(BuildSchedule(terms)) <= payment {
+			return n
+		}
+	}
+	return maxTerm
+}
+
+// AmortizingInstallment returns the amount of the first installment that repays principal.
+func AmortizingInstallment(installments []entities.Installment) money.Money {
+	for _, installment := range installments {
+		if installment.Principal.IsPositive() {
+			return installment.Amount
+		}
+	}
+	return money.Zero
+}
@@ -0,0 +1,60 @@
+package finance
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// StatementDaysBeforeDue is the number of days between a cycle's closing date and its due date.
+const StatementDaysBeforeDue = 15
+
+// BillingCycle returns the closing date and due date of the latest cycle that
+// closed on or before asOf. Each cycle closes StatementDaysBeforeDue days before
+// the account's monthly due date.
+func BillingCycle(monthlyDueDate int, asOf time.Time) (closing time.Time, due time.Time) {
+	asOf = DateOf(asOf)
+	due = DueDate(asOf, monthlyDueDate, 0)
+	closing = due.AddDate(0, 0, -StatementDaysBeforeDue)
+	if closing.After(asOf) {
+		due = DueDate(asOf, monthlyDueDate, -1)
+		closing = due.AddDate(0, 0, -StatementDaysBeforeDue)
+	}
+	return closing, due
+}
+
+// BuildStatement summarizes the history rows of a cycle. History amounts are
+// signed (payments are negative), so the closing balance is the opening balance
+// plus every movement of the period.
+func BuildStatement(creditAccountID uint, periodStart, periodEnd, dueDate time.Time, opening money.Money, history []entities.CreditAccountHistory) entities.Statement {
+	statement := entities.Statement{
+		CreditAccountID: creditAccountID,
+		PeriodStart:     DateOf(periodStart),
+		PeriodEnd:       DateOf(periodEnd),
+		DueDate:         DateOf(dueDate),
+		OpeningBalance:  opening,
+	}
+
+	closing := opening
+	for _, entry := range history {
+		closing += entry.Amount
+		switch entry.TransactionType {
+		case enums.Purchase:
+			statement.Purchases += entry.Amount
+		case enums.Payment, enums.EarlyPayment:
+			statement.Payments -= entry.Amount
+		case enums.InterestAccrual, enums.InterestCapitalized, enums.PenaltyInterest:
+			statement.Interest += entry.Amount
+		case enums.LateFeeApplied:
+			statement.Fees += entry.Amount
+		default:
+			statement.Adjustments += entry.Amount
+		}
+	}
+
+	statement.ClosingBalance = closing
+	statement.AmountDue = closing.Max(money.Zero)
+	return statement
+}
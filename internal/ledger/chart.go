@@ -0,0 +1,72 @@
+// Package ledger describes the double-entry bookkeeping behind credit accounts: the chart of
+// accounts every establishment gets and the journal lines each kind of transaction posts.
+package ledger
+
+import "ApiRestFinance/internal/model/entities/enums"
+
+// AccountCode identifies an account in the chart of accounts of an establishment.
+type AccountCode string
+
+const (
+	Cash                      AccountCode = "1000"
+	PrincipalReceivable       AccountCode = "1100"
+	InterestReceivable        AccountCode = "1110"
+	FeesReceivable            AccountCode = "1120"
+	PenaltyInterestReceivable AccountCode = "1130"
+	SalesRevenue              AccountCode = "4000"
+	InterestIncome            AccountCode = "4100"
+	FeeIncome                 AccountCode = "4200"
+	PenaltyInterestIncome     AccountCode = "4300"
+	BadDebtExpense            AccountCode = "5000"
+	AdjustmentExpense         AccountCode = "5100"
+)
+
+// ChartAccount is an entry of the chart of accounts.
+type ChartAccount struct {
+	Code AccountCode
+	Name string
+	Type enums.LedgerAccountType
+}
+
+// Chart is the chart of accounts created for every establishment.
+var Chart = []ChartAccount{
+	{Cash, "Cash", enums.AssetAccount},
+	{PrincipalReceivable, "Receivables - principal", enums.AssetAccount},
+	{InterestReceivable, "Receivables - interest", enums.AssetAccount},
+	{FeesReceivable, "Receivables - fees", enums.AssetAccount},
+	{PenaltyInterestReceivable, "Receivables - penalty interest", enums.AssetAccount},
+	{SalesRevenue, "Sales on credit", enums.RevenueAccount},
+	{InterestIncome, "Interest income", enums.RevenueAccount},
+	{FeeIncome, "Fee income", enums.RevenueAccount},
+	{PenaltyInterestIncome, "Penalty interest income", enums.RevenueAccount},
+	{BadDebtExpense, "Bad debt expense", enums.ExpenseAccount},
+	{AdjustmentExpense, "Balance adjustments", enums.ExpenseAccount},
+}
+
+// receivables maps each debt component to the receivable account that carries it.
+var receivables = map[enums.AllocationComponent]AccountCode{
+	enums.FeesComponent:            FeesReceivable,
+	enums.PenaltyInterestComponent: PenaltyInterestReceivable,
+	enums.InterestComponent:        InterestReceivable,
+	enums.PrincipalComponent:       PrincipalReceivable,
+}
+
+// ReceivableFor returns the receivable account of a debt component.
+func ReceivableFor(component enums.AllocationComponent) AccountCode {
+	return receivables[component]
+}
+
+// ComponentOf returns the debt component carried by a receivable account.
+func ComponentOf(code AccountCode) (enums.AllocationComponent, bool) {
+	for component, receivable := range receivables {
+		if receivable == code {
+			return component, true
+		}
+	}
+	return "", false
+}
+
+// Receivables returns the codes of the accounts that make up what clients owe.
+func Receivables() []AccountCode {
+	return []AccountCode{PrincipalReceivable, InterestReceivable, FeesReceivable, PenaltyInterestReceivable}
+}
@@ -0,0 +1,103 @@
+package ledger
+
+import (
+	"errors"
+
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// ErrUnbalanced is returned when the debits of a journal entry do not match its credits.
+var ErrUnbalanced = errors.New("journal entry is not balanced")
+
+// Line is one side of a journal entry. Exactly one of Debit and Credit is set.
+type Line struct {
+	Account AccountCode
+	Debit   money.Money
+	Credit  money.Money
+}
+
+func debit(account AccountCode, amount money.Money) Line {
+	return Line{Account: account, Debit: amount}
+}
+
+func credit(account AccountCode, amount money.Money) Line {
+	return Line{Account: account, Credit: amount}
+}
+
+// Lines returns the journal lines posted by a transaction. Payments and write-offs are split
+// across the receivables by their allocation. It reports false for transaction types that do
+// not move money, and for reversals, which mirror the entry they cancel.
+func Lines(transactionType enums.TransactionType, amount money.Money, allocation finance.Balances) ([]Line, bool) {
+	switch transactionType {
+	case enums.Purchase:
+		return []Line{debit(PrincipalReceivable, amount), credit(SalesRevenue, amount)}, true
+	case enums.Disbursement:
+		return []Line{debit(PrincipalReceivable, amount), credit(Cash, amount)}, true
+	case enums.InterestAccrual:
+		return []Line{debit(InterestReceivable, amount), credit(InterestIncome, amount)}, true
+	case enums.InterestCapitalized:
+		return []Line{debit(PrincipalReceivable, amount), credit(InterestIncome, amount)}, true
+	case enums.PenaltyInterest:
+		return []Line{debit(PenaltyInterestReceivable, amount), credit(PenaltyInterestIncome, amount)}, true
+	case enums.LateFeeApplied:
+		return []Line{debit(FeesReceivable, amount), credit(FeeIncome, amount)}, true
+	case enums.Payment, enums.EarlyPayment:
+		return append([]Line{debit(Cash, amount)}, split(allocation, credit)...), true
+	case enums.WriteOff:
+		return append([]Line{debit(BadDebtExpense, amount)}, split(allocation, credit)...), true
+	case enums.Adjustment:
+		if amount.IsNegative() {
+			return []Line{debit(AdjustmentExpense, amount.Neg()), credit(PrincipalReceivable, amount.Neg())}, true
+		}
+		return []Line{debit(PrincipalReceivable, amount), credit(AdjustmentExpense, amount)}, true
+	default:
+		return nil, false
+	}
+}
+
+// split returns one line per component of the allocation, in the default allocation order.
+func split(allocation finance.Balances, side func(AccountCode, money.Money) Line) []Line {
+	var lines []Line
+	for _, component := range finance.DefaultAllocationOrder {
+		if amount := allocation[component]; amount.IsPositive() {
+			lines = append(lines, side(ReceivableFor(component), amount))
+		}
+	}
+	return lines
+}
+
+// Mirror returns the lines that cancel the given ones.
+func Mirror(lines []Line) []Line {
+	mirrored := make([]Line, len(lines))
+	for i, line := range lines {
+		mirrored[i] = Line{Account: line.Account, Debit: line.Credit, Credit: line.Debit}
+	}
+	return mirrored
+}
+
+// Balanced reports whether the lines form a valid entry: non-negative amounts whose debits equal
+// their credits.
+func Balanced(lines []Line) bool {
+	var debits, credits money.Money
+	for _, line := range lines {
+		if line.Debit.IsNegative() || line.Credit.IsNegative() {
+			return false
+		}
+		debits += line.Debit
+		credits += line.Credit
+	}
+	return len(lines) > 0 && debits == credits
+}
+
+// ReceivableChange returns how the lines change each debt component of a credit account.
+func ReceivableChange(lines []Line) finance.Balances {
+	change := finance.Balances{}
+	for _, line := range lines {
+		if component, ok := ComponentOf(line.Account); ok {
+			change[component] += line.Debit - line.Credit
+		}
+	}
+	return change
+}
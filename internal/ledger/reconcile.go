@@ -0,0 +1,43 @@
+package ledger
+
+import (
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// BalanceEffect returns how a transaction of the given type changes the balance of its credit
+// account. It reports false for types that do not move money and for reversals.
+func BalanceEffect(transactionType enums.TransactionType, amount money.Money) (money.Money, bool) {
+	lines, ok := Lines(transactionType, amount, finance.Balances{enums.PrincipalComponent: amount})
+	if !ok {
+		return money.Zero, false
+	}
+	var effect money.Money
+	for _, change := range ReceivableChange(lines) {
+		effect += change
+	}
+	return effect, true
+}
+
+// TransactionBalance rebuilds the balance of a credit account from its transactions.
+// Reversals cancel the transaction they reference.
+func TransactionBalance(transactions []entities.Transaction) money.Money {
+	effects := make(map[uint]money.Money, len(transactions))
+	for _, transaction := range transactions {
+		if effect, ok := BalanceEffect(transaction.TransactionType, transaction.Amount); ok {
+			effects[transaction.ID] = effect
+		}
+	}
+
+	var balance money.Money
+	for _, transaction := range transactions {
+		if transaction.TransactionType == enums.Reversal && transaction.ReversalOfID != nil {
+			balance -= effects[*transaction.ReversalOfID]
+			continue
+		}
+		balance += effects[transaction.ID]
+	}
+	return balance
+}
@@ -0,0 +1,42 @@
+package mailer
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+type logMailer struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewLogMailer creates a Mailer for local development that appends every message to a file,
+// or writes it to the standard logger when path is empty. Nothing is delivered.
+func NewLogMailer(path string) Mailer {
+	return &logMailer{path: path}
+}
+
+// Send records a message.
+func (m *logMailer) Send(message Message) error {
+	entry := fmt.Sprintf("--- %s\nTo: %s\nSubject: %s\n\n%s\n", time.Now().Format(time.RFC3339), message.To, message.Subject, message.Body)
+	if m.path == "" {
+		log.Print("mailer: ", entry)
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("error opening mail log: %w", err)
+	}
+	defer file.Close()
+	if _, err := io.WriteString(file, entry); err != nil {
+		return fmt.Errorf("error writing mail log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+// Package mailer sends the emails of the API. Deployments use SMTP, local development writes
+// the messages to a log file instead.
+package mailer
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	Send(message Message) error
+}
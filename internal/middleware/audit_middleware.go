@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"ApiRestFinance/internal/audit"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// auditedTables maps the first segment of a route to the table whose rows it changes. Entities
+// without a table are audited without a diff.
+var auditedTables = map[string]string{
+	"establishments":  "establishments",
+	"clients":         "clients",
+	"admins":          "admins",
+	"products":        "products",
+	"credit-accounts": "credit_accounts",
+	"credit-requests": "credit_requests",
+	"transactions":    "transactions",
+	"late-fees":       "late_fees",
+	"late-fee-rules":  "late_fee_rules",
+	"installments":    "installments",
+	"limit-changes":   "credit_limit_changes",
+	"users":           "users",
+}
+
+// AuditMiddleware appends an entry to the audit log for every successful state-changing
+// request, with the fields of the entity the request changed. Replayed idempotent requests
+// changed nothing and are not recorded again.
+func AuditMiddleware(repo repository.AuditLogRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead || c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		entityType := auditEntityType(c.FullPath())
+		table := auditedTables[entityType]
+		entityID := ""
+		if len(c.Params) > 0 {
+			entityID = c.Params[0].Value
+		}
+
+		var before map[string]interface{}
+		if table != "" && entityID != "" {
+			snapshot, err := repo.Snapshot(table, entityID)
+			if err != nil {
+				log.Println("Error reading audit snapshot: ", err)
+			}
+			before = snapshot
+		}
+
+		writer := &capturingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		if writer.Status() >= http.StatusBadRequest || writer.Header().Get("Idempotent-Replayed") == "true" {
+			return
+		}
+
+		// Creates return the new entity
+		if entityID == "" {
+			entityID = responseID(writer.body.Bytes())
+		}
+		var after map[string]interface{}
+		if table != "" && entityID != "" {
+			snapshot, err := repo.Snapshot(table, entityID)
+			if err != nil {
+				log.Println("Error reading audit snapshot: ", err)
+			}
+			after = snapshot
+		}
+
+		principal := PrincipalFromContext(c)
+		entry := &entities.AuditLog{
+			ActorUserID:     principal.UserID,
+			EstablishmentID: auditEstablishment(repo, entityType, entityID, before, after),
+			Action:          c.Request.Method + " " + c.FullPath(),
+			EntityType:      entityType,
+			EntityID:        entityID,
+			IP:              c.ClientIP(),
+			RequestID:       GetRequestIDFromContext(c),
+			StatusCode:      writer.Status(),
+		}
+		if entry.EstablishmentID == 0 {
+			entry.EstablishmentID = principal.EstablishmentID
+		}
+		entry.Before, entry.After = audit.Diff(before, after)
+		if err := repo.Append(entry); err != nil {
+			log.Println("Error appending audit log entry: ", err)
+		}
+	}
+}
+
+// auditEntityType returns the first segment of a route after the API prefix.
+func auditEntityType(route string) string {
+	segments := strings.Split(strings.TrimPrefix(route, "/api/v1/"), "/")
+	return segments[0]
+}
+
+// auditEstablishment returns the establishment an entity belongs to, looking through the
+// credit account for the records that belong to one. It returns 0 when there is none.
+func auditEstablishment(repo repository.AuditLogRepository, entityType, entityID string, snapshots ...map[string]interface{}) uint {
+	if entityType == "establishments" {
+		var id uint
+		if _, err := fmt.Sscan(entityID, &id); err == nil {
+			return id
+		}
+	}
+	for _, snapshot := range snapshots {
+		if id := snapshotID(snapshot, "establishment_id"); id != 0 {
+			return id
+		}
+	}
+	for _, snapshot := range snapshots {
+		if accountID := snapshotID(snapshot, "credit_account_id"); accountID != 0 {
+			account, err := repo.Snapshot("credit_accounts", fmt.Sprint(accountID))
+			if err != nil {
+				log.Println("Error reading audit snapshot: ", err)
+			}
+			return snapshotID(account, "establishment_id")
+		}
+	}
+	return 0
+}
+
+// snapshotID reads an integer column of a snapshot, 0 when it is missing or null.
+func snapshotID(snapshot map[string]interface{}, column string) uint {
+	var id uint
+	if value, ok := snapshot[column]; ok && value != nil {
+		if _, err := fmt.Sscan(fmt.Sprint(value), &id); err != nil {
+			return 0
+		}
+	}
+	return id
+}
+
+// responseID returns the "id" field of a JSON response body, or "" when it has none.
+func responseID(body []byte) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return ""
+	}
+	raw, ok := fields["id"]
+	if !ok {
+		return ""
+	}
+	return strings.Trim(string(raw), `"`)
+}
@@ -1,15 +1,19 @@
 package middleware
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 
+	"ApiRestFinance/internal/auth"
+	"ApiRestFinance/internal/util"
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v4"
 )
 
-// AuthMiddleware is a JWT authentication middleware for Gin
+// principalKey is the context key of the authenticated caller.
+const principalKey = "principal"
+
+// AuthMiddleware is a JWT authentication middleware for Gin. It resolves the caller of the
+// request into an auth.Principal once, for later middlewares, controllers and services.
 func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
@@ -25,51 +29,26 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		tokenString := tokenParts[1]
-
-		// Parse and validate the JWT token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Verify signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(jwtSecret), nil
-		})
-
-		if err != nil || !token.Valid {
+		// Parse and validate the access token, refresh tokens are only accepted by /refresh and /logout
+		claims, err := util.ParseAccessToken(tokenParts[1], jwtSecret)
+		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
 			return
 		}
 
-		// Extract claims and set them in the context
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Unable to extract claims"})
-			return
-		}
-
-		c.Set("claims", claims) // Set claims in the context
+		c.Set(principalKey, claims.Principal()) // Set the caller in the context
 		c.Next()
 	}
 }
 
-func GetUserIDFromContext(ctx *gin.Context) uint {
-	userID, exists := ctx.Get("user_id")
-	if !exists {
-		return 0
-	}
-
-	userIDUint, ok := userID.(uint)
-	if !ok {
-		return 0
-	}
-	return userIDUint
+// PrincipalFromContext returns the caller set by AuthMiddleware, the zero Principal when there is none.
+func PrincipalFromContext(ctx *gin.Context) auth.Principal {
+	principal, _ := ctx.Get(principalKey)
+	p, _ := principal.(auth.Principal)
+	return p
 }
 
-func extractTokenFromHeader(authHeader string) string {
-	parts := strings.Split(authHeader, " ")
-	if len(parts) == 2 && parts[0] == "Bearer" {
-		return parts[1]
-	}
-	return ""
+// GetUserIDFromContext returns the user ID of the caller, 0 when the request is not authenticated.
+func GetUserIDFromContext(ctx *gin.Context) uint {
+	return PrincipalFromContext(ctx).UserID
 }
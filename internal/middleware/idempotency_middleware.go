@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// IdempotencyKeyHeader is the header clients send to make a request safe to retry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength bounds the header value stored in the database.
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyMiddleware requires an Idempotency-Key header and makes the request run at most once
+// per key. A retry with the same key and payload replays the stored response, a retry with a
+// different payload is rejected, and a retry while the first request is in flight gets 409.
+func IdempotencyMiddleware(repo repository.IdempotencyKeyRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key header is missing"})
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key header is too long"})
+			return
+		}
+
+		// Hash the target and payload, then restore the body for the handler
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Unable to read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		hash := sha256.New()
+		hash.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+		hash.Write(body)
+		requestHash := hex.EncodeToString(hash.Sum(nil))
+
+		record := &entities.IdempotencyKey{
+			Scope:       fmt.Sprintf("%s %s user:%v", c.Request.Method, c.FullPath(), GetUserIDFromContext(c)),
+			Key:         key,
+			RequestHash: requestHash,
+		}
+		reserved, err := repo.Reserve(record)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if !reserved {
+			existing, err := repo.Get(record.Scope, key)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			switch {
+			case existing.RequestHash != requestHash:
+				c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "Idempotency-Key was already used with a different request"})
+			case existing.CompletedAt == nil:
+				c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "A request with this Idempotency-Key is still being processed"})
+			default:
+				c.Header("Idempotent-Replayed", "true")
+				c.Data(existing.StatusCode, existing.ContentType, existing.ResponseBody)
+				c.Abort()
+			}
+			return
+		}
+
+		// Run the handler while capturing its response. A handler that panics answers 500
+		// through the recovery middleware, so its key is released as well
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				_ = repo.Release(record)
+				panic(recovered)
+			}
+		}()
+		writer := &capturingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		// Server errors are not stored so the client can retry them
+		if writer.Status() >= http.StatusInternalServerError {
+			_ = repo.Release(record)
+			return
+		}
+		_ = repo.Complete(record, writer.Status(), writer.Header().Get("Content-Type"), writer.body.Bytes())
+	}
+}
+
+// capturingWriter copies the response body while it is written to the client.
+type capturingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *capturingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *capturingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ApiRestFinance/internal/model/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// memoryIdempotencyKeys keeps the keys in memory, by scope and value.
+type memoryIdempotencyKeys struct {
+	keys map[string]*entities.IdempotencyKey
+}
+
+func (m *memoryIdempotencyKeys) Reserve(idempotencyKey *entities.IdempotencyKey) (bool, error) {
+	id := idempotencyKey.Scope + "\n" + idempotencyKey.Key
+	if _, ok := m.keys[id]; ok {
+		return false, nil
+	}
+	m.keys[id] = idempotencyKey
+	return true, nil
+}
+
+func (m *memoryIdempotencyKeys) Get(scope, key string) (*entities.IdempotencyKey, error) {
+	return m.keys[scope+"\n"+key], nil
+}
+
+func (m *memoryIdempotencyKeys) Complete(idempotencyKey *entities.IdempotencyKey, statusCode int, contentType string, body []byte) error {
+	idempotencyKey.StatusCode = statusCode
+	idempotencyKey.ContentType = contentType
+	idempotencyKey.ResponseBody = body
+	return nil
+}
+
+func (m *memoryIdempotencyKeys) Release(idempotencyKey *entities.IdempotencyKey) error {
+	delete(m.keys, idempotencyKey.Scope+"\n"+idempotencyKey.Key)
+	return nil
+}
+
+func TestIdempotencyMiddlewareReleasesKeysOfPanickingHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := &memoryIdempotencyKeys{keys: map[string]*entities.IdempotencyKey{}}
+	panics := true
+
+	router := gin.New()
+	router.Use(gin.RecoveryWithWriter(io.Discard))
+	router.POST("/payments", IdempotencyMiddleware(repo), func(c *gin.Context) {
+		if panics {
+			panic("handler failed")
+		}
+		c.Status(http.StatusCreated)
+	})
+	send := func() int {
+		req := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(`{"amount":10}`))
+		req.Header.Set(IdempotencyKeyHeader, "retry-me")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if got := send(); got != http.StatusInternalServerError {
+		t.Fatalf("panicking handler answered %d, want %d", got, http.StatusInternalServerError)
+	}
+	if len(repo.keys) != 0 {
+		t.Fatalf("key of the panicking request was not released")
+	}
+	panics = false
+	if got := send(); got != http.StatusCreated {
+		t.Errorf("retry answered %d, want %d", got, http.StatusCreated)
+	}
+}
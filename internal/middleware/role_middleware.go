@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	"ApiRestFinance/internal/model/entities/enums"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireRoles lets the request through only when the access token carries one of the given
+// roles. Platform admins pass every guard. It must run after AuthMiddleware.
+func RequireRoles(roles ...enums.RoleName) gin.HandlerFunc {
+	allowed := make(map[enums.RoleName]bool, len(roles)+1)
+	allowed[enums.PlatformAdminRole] = true
+	for _, role := range roles {
+		allowed[role] = true
+	}
+
+	return func(c *gin.Context) {
+		for _, role := range RolesFromContext(c) {
+			if allowed[role] {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+	}
+}
+
+// RolesFromContext returns the roles carried by the access token of the request.
+func RolesFromContext(c *gin.Context) []enums.RoleName {
+	return PrincipalFromContext(c).Roles
+}
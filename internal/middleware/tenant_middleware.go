@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"ApiRestFinance/internal/repository"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TenantFromContext returns the tenant of the caller. Platform admins are unrestricted, staff
+// are bound to the establishment of their access token and everyone else to their own data.
+func TenantFromContext(c *gin.Context) repository.Tenant {
+	principal := PrincipalFromContext(c)
+	if principal.IsPlatformAdmin() {
+		return repository.Tenant{}
+	}
+	if principal.EstablishmentID != 0 {
+		return repository.Tenant{EstablishmentID: principal.EstablishmentID}
+	}
+	return repository.Tenant{ClientUserID: principal.UserID}
+}
+
+// RequireTenant answers 404 when the resource identified by a path parameter belongs to
+// another tenant. Invalid IDs are left to the handler.
+func RequireTenant(repo repository.TenantRepository, resource repository.Resource, param string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param(param))
+		if err != nil {
+			c.Next()
+			return
+		}
+		requireOwnership(c, repo, resource, uint(id))
+	}
+}
+
+// RequireTenantBody answers 404 when the resource referenced by a field of the JSON body
+// belongs to another tenant. A missing field references nothing the tenant owns.
+func RequireTenantBody(repo repository.TenantRepository, resource repository.Resource, field string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if TenantFromContext(c).Unrestricted() {
+			c.Next()
+			return
+		}
+
+		// Read the field, then restore the body for the handler
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Unable to read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			c.Next() // Malformed bodies are rejected by the handler
+			return
+		}
+		var id uint
+		if raw, ok := fields[field]; ok {
+			if err := json.Unmarshal(raw, &id); err != nil {
+				c.Next()
+				return
+			}
+		}
+		requireOwnership(c, repo, resource, id)
+	}
+}
+
+func requireOwnership(c *gin.Context, repo repository.TenantRepository, resource repository.Resource, id uint) {
+	if err := repo.Owns(TenantFromContext(c), resource, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Resource not found"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Next()
+}
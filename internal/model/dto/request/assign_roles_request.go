@@ -0,0 +1,8 @@
+package request
+
+import "ApiRestFinance/internal/model/entities/enums"
+
+type AssignRolesRequest struct {
+	Roles           []enums.RoleName `json:"roles" binding:"required,min=1,dive,oneof=platform_admin establishment_admin cashier client"`
+	EstablishmentID *uint            `json:"establishment_id" binding:"omitempty"` // Required for cashiers
+}
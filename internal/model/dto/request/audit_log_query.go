@@ -0,0 +1,11 @@
+package request
+
+// AuditLogQuery represents the filters of the audit log, on top of the list parameters.
+type AuditLogQuery struct {
+	ListQuery
+	ActorUserID     uint   `form:"actor_id" binding:"omitempty"`
+	EstablishmentID uint   `form:"establishment_id" binding:"omitempty"`
+	Action          string `form:"action" binding:"omitempty"`
+	EntityType      string `form:"entity_type" binding:"omitempty"`
+	EntityID        string `form:"entity_id" binding:"omitempty"`
+}
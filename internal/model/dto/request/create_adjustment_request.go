@@ -0,0 +1,12 @@
+package request
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+type CreateAdjustmentRequest struct {
+	Amount      money.Money      `json:"amount" swaggertype:"number" binding:"required,ne=0"` // Positive increases the balance, negative decreases it
+	ReasonCode  enums.ReasonCode `json:"reason_code" binding:"required,oneof=DUPLICATE WRONG_AMOUNT WRONG_ACCOUNT DISPUTE FEE_WAIVER RETURNED_PAYMENT OTHER"`
+	Description string           `json:"description" binding:"required"`
+}
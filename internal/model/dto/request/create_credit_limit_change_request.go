@@ -0,0 +1,8 @@
+package request
+
+import "ApiRestFinance/internal/model/money"
+
+type CreateCreditLimitChangeRequest struct {
+	NewLimit money.Money `json:"new_limit" swaggertype:"number" binding:"required,gt=0"`
+	Reason   string      `json:"reason" binding:"omitempty,max=500"` // Optional
+}
@@ -1,14 +1,22 @@
 package request
 
-import "ApiRestFinance/internal/model/entities/enums"
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
 
 type CreateCreditRequest struct {
-	ClientID             uint               `json:"client_id" binding:"required"`
-	EstablishmentID      uint               `json:"establishment_id" binding:"required"`
-	RequestedCreditLimit float64            `json:"requested_credit_limit" binding:"required,gt=0"`
-	MonthlyDueDate       int                `json:"monthly_due_date" binding:"required,min=1,max=31"`
-	InterestRate         float64            `json:"interest_rate" binding:"required,gt=0"`
-	InterestType         enums.InterestType `json:"interest_type" binding:"required"`
-	CreditType           enums.CreditType   `json:"credit_type" binding:"required"`
-	GracePeriod          int                `json:"grace_period" binding:"omitempty,min=0"` // Optional
+	ClientID             uint                       `json:"client_id" binding:"required"`
+	EstablishmentID      uint                       `json:"establishment_id" binding:"required"`
+	RequestedCreditLimit money.Money                `json:"requested_credit_limit" swaggertype:"number" binding:"required,gt=0"`
+	MonthlyDueDate       int                        `json:"monthly_due_date" binding:"required,min=1,max=31"`
+	InterestRate         float64                    `json:"interest_rate" binding:"required,gt=0"`
+	InterestType         enums.InterestType         `json:"interest_type" binding:"required"`
+	DayCount             enums.DayCountConvention   `json:"day_count" binding:"omitempty,oneof=ACT_360 ACT_365"` // Optional, defaults to ACT_360
+	Compounding          enums.CompoundingFrequency `json:"compounding" binding:"omitempty,oneof=DAILY MONTHLY"` // Optional, defaults to MONTHLY
+	CreditType           enums.CreditType           `json:"credit_type" binding:"required"`
+	Term                 int                        `json:"term" binding:"omitempty,min=1,max=360"`                             // Required for LONG_TERM, in months
+	AmortizationMethod   enums.AmortizationMethod   `json:"amortization_method" binding:"omitempty,oneof=FRENCH GERMAN BULLET"` // Optional, defaults to FRENCH
+	GracePeriod          int                        `json:"grace_period" binding:"omitempty,min=0"`                             // Optional
+	GraceType            enums.GraceType            `json:"grace_type" binding:"omitempty,oneof=TOTAL PARTIAL"`                 // Optional, defaults to PARTIAL
 }
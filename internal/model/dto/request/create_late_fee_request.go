@@ -1,6 +1,8 @@
 package request
 
+import "ApiRestFinance/internal/model/money"
+
 type CreateLateFeeRequest struct {
-	CreditAccountID uint    `json:"credit_account_id" binding:"required"`
-	Amount          float64 `json:"amount" binding:"required,gt=0"`
+	CreditAccountID uint        `json:"credit_account_id" binding:"required"`
+	Amount          money.Money `json:"amount" swaggertype:"number" binding:"required,gt=0"`
 }
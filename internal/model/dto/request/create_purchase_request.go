@@ -0,0 +1,16 @@
+package request
+
+import "ApiRestFinance/internal/model/money"
+
+// CreatePurchaseRequest charges the products of an establishment to a credit account.
+type CreatePurchaseRequest struct {
+	Items       []PurchaseItemRequest `json:"items" binding:"required,min=1,max=100,dive"`
+	Description string                `json:"description" binding:"max=255"` // Optional, defaults to the list of items
+}
+
+// PurchaseItemRequest is one product of a purchase.
+type PurchaseItemRequest struct {
+	ProductID uint         `json:"product_id" binding:"required"`
+	Quantity  int          `json:"quantity" binding:"required,gt=0"`
+	UnitPrice *money.Money `json:"unit_price" swaggertype:"number" binding:"omitempty,gt=0"` // Optional, overrides the catalog price
+}
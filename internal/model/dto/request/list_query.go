@@ -0,0 +1,22 @@
+package request
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/money"
+)
+
+// ListQuery represents the pagination, sorting and filter parameters of list endpoints. Each
+// endpoint documents the sort fields and filters it supports, the others are ignored.
+type ListQuery struct {
+	Limit      int          `form:"limit" binding:"omitempty,min=1,max=200"` // Defaults to 50
+	Offset     int          `form:"offset" binding:"omitempty,min=0"`
+	Cursor     string       `form:"cursor" binding:"omitempty"`                          // Next cursor of the previous page, replaces offset
+	Sort       string       `form:"sort" binding:"omitempty"`                            // Field, prefixed with "-" for descending order
+	Status     string       `form:"status" binding:"omitempty"`                          // Status or type, depending on the endpoint
+	From       *time.Time   `form:"from" time_format:"2006-01-02" binding:"omitempty"`   // Inclusive
+	To         *time.Time   `form:"to" time_format:"2006-01-02" binding:"omitempty"`     // Inclusive
+	MinAmount  *money.Money `form:"min_amount" swaggertype:"number" binding:"omitempty"` // Decimal amount, e.g. 12.50
+	MaxAmount  *money.Money `form:"max_amount" swaggertype:"number" binding:"omitempty"`
+	CreditType string       `form:"credit_type" binding:"omitempty,oneof=SHORT_TERM LONG_TERM"`
+}
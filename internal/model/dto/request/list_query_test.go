@@ -0,0 +1,27 @@
+package request
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+func TestListQueryBindsAmountsAsDecimals(t *testing.T) {
+	var query ListQuery
+	req := httptest.NewRequest("GET", "/transactions?min_amount=10.05&max_amount=250", nil)
+	if err := binding.Query.Bind(req, &query); err != nil {
+		t.Fatalf("error binding amounts: %v", err)
+	}
+	if query.MinAmount == nil || *query.MinAmount != 1005 || query.MaxAmount == nil || *query.MaxAmount != 25000 {
+		t.Errorf("bound amounts %v and %v, want 10.05 and 250.00", query.MinAmount, query.MaxAmount)
+	}
+
+	for _, value := range []string{"NaN", "Inf", "-Inf", "1e400", "abc"} {
+		var query ListQuery
+		req := httptest.NewRequest("GET", "/transactions?min_amount="+value, nil)
+		if err := binding.Query.Bind(req, &query); err == nil {
+			t.Errorf("min_amount=%s was accepted as %v", value, query.MinAmount)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package request
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// PrepaymentRequest pays off part or all of the outstanding principal of a LONG_TERM account ahead of its schedule.
+type PrepaymentRequest struct {
+	Amount      money.Money          `json:"amount" swaggertype:"number" binding:"required,gt=0"`
+	Mode        enums.PrepaymentMode `json:"mode" binding:"required,oneof=REDUCE_TERM REDUCE_INSTALLMENT"` // Ignored when the account is paid off
+	Description string               `json:"description" binding:"max=255"`                                // Optional
+}
@@ -0,0 +1,9 @@
+package request
+
+import "ApiRestFinance/internal/model/entities/enums"
+
+// RegenerateScheduleRequest optionally changes the loan terms before the schedule is rebuilt.
+type RegenerateScheduleRequest struct {
+	Term               int                      `json:"term" binding:"omitempty,min=1,max=360"`                             // Optional, keeps the current term
+	AmortizationMethod enums.AmortizationMethod `json:"amortization_method" binding:"omitempty,oneof=FRENCH GERMAN BULLET"` // Optional, keeps the current method
+}
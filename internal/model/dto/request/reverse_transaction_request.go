@@ -0,0 +1,8 @@
+package request
+
+import "ApiRestFinance/internal/model/entities/enums"
+
+type ReverseTransactionRequest struct {
+	ReasonCode  enums.ReasonCode `json:"reason_code" binding:"required,oneof=DUPLICATE WRONG_AMOUNT WRONG_ACCOUNT DISPUTE FEE_WAIVER RETURNED_PAYMENT OTHER"`
+	Description string           `json:"description" binding:"omitempty"` // Optional
+}
@@ -0,0 +1,8 @@
+package request
+
+// TransactionSearchQuery represents the filters of the transaction and history searches, on
+// top of the list parameters.
+type TransactionSearchQuery struct {
+	ListQuery
+	Description string `form:"description" binding:"omitempty,max=255"` // Text contained in the description, case insensitive
+}
@@ -1,8 +1,10 @@
 package request
 
+import "ApiRestFinance/internal/model/money"
+
 type UpdateClientRequest struct {
-	Phone       string  `json:"phone" binding:"required"`
-	Email       string  `json:"email" binding:"required,email"`
-	CreditLimit float64 `json:"credit_limit" binding:"required,min=0"`
-	IsActive    bool    `json:"is_active"`
+	Phone       string      `json:"phone" binding:"required"`
+	Email       string      `json:"email" binding:"required,email"`
+	CreditLimit money.Money `json:"credit_limit" swaggertype:"number" binding:"required,min=0"`
+	IsActive    bool        `json:"is_active"`
 }
@@ -2,16 +2,23 @@ package request
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 )
 
 type UpdateCreditAccountRequest struct {
-	CreditLimit    float64            `json:"credit_limit" binding:"omitempty,gt=0"`
-	MonthlyDueDate int                `json:"monthly_due_date" binding:"omitempty,min=1,max=31"`
-	InterestRate   float64            `json:"interest_rate" binding:"omitempty,gt=0"`
-	InterestType   enums.InterestType `json:"interest_type" binding:"omitempty"`
-	CreditType     enums.CreditType   `json:"credit_type" binding:"omitempty"`
-	GracePeriod    int                `json:"grace_period" binding:"omitempty,min=0"`
-	IsBlocked      bool               `json:"is_blocked"`
-	LateFeeRuleID  uint               `json:"late_fee_rule_id" binding:"omitempty"` // Optional
-	CurrentBalance float64            `json:"current_balance"`                      // Added CurrentBalance field
+	CreditLimit         money.Money                `json:"credit_limit" swaggertype:"number" binding:"omitempty,gt=0"`
+	MonthlyDueDate      int                        `json:"monthly_due_date" binding:"omitempty,min=1,max=31"`
+	InterestRate        float64                    `json:"interest_rate" binding:"omitempty,gt=0"`
+	InterestType        enums.InterestType         `json:"interest_type" binding:"omitempty"`
+	PenaltyInterestRate *float64                   `json:"penalty_interest_rate" binding:"omitempty,min=0"` // Left unchanged when omitted
+	DayCount            enums.DayCountConvention   `json:"day_count" binding:"omitempty,oneof=ACT_360 ACT_365"`
+	Compounding         enums.CompoundingFrequency `json:"compounding" binding:"omitempty,oneof=DAILY MONTHLY"`
+	CreditType          enums.CreditType           `json:"credit_type" binding:"omitempty"`
+	Term                int                        `json:"term" binding:"omitempty,min=1,max=360"`
+	AmortizationMethod  enums.AmortizationMethod   `json:"amortization_method" binding:"omitempty,oneof=FRENCH GERMAN BULLET"`
+	GracePeriod         *int                       `json:"grace_period" binding:"omitempty,min=0"` // Left unchanged when omitted
+	GraceType           enums.GraceType            `json:"grace_type" binding:"omitempty,oneof=TOTAL PARTIAL"`
+	IsBlocked           *bool                      `json:"is_blocked"`                           // Left unchanged when omitted
+	LateFeeRuleID       uint                       `json:"late_fee_rule_id" binding:"omitempty"` // Optional
+	CurrentBalance      money.Money                `json:"current_balance" swaggertype:"number"` // Added CurrentBalance field
 }
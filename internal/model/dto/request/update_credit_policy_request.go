@@ -0,0 +1,14 @@
+package request
+
+import "ApiRestFinance/internal/model/money"
+
+type UpdateCreditPolicyRequest struct {
+	Enabled              bool        `json:"enabled"`
+	AutoApproveMinScore  int         `json:"auto_approve_min_score" binding:"min=0,max=1000"`
+	AutoRejectBelowScore int         `json:"auto_reject_below_score" binding:"min=0,max=1000,ltefield=AutoApproveMinScore"`
+	MaxAutoApproveLimit  money.Money `json:"max_auto_approve_limit" swaggertype:"number" binding:"min=0"` // Optional, 0 for no cap
+	LimitReviewPercent   float64     `json:"limit_review_percent" binding:"min=0,max=100"`                // Optional, 0 disables automatic limit reviews
+	MinInterestRate      float64     `json:"min_interest_rate" binding:"min=0"`                           // Lowest TEA approved automatically, as a percentage
+	MaxGracePeriod       int         `json:"max_grace_period" binding:"min=0"`                            // Optional, 0 leaves every request with a grace period for manual review
+	MaxTerm              int         `json:"max_term" binding:"min=0,max=360"`                            // Optional, 0 leaves every LONG_TERM request for manual review
+}
@@ -0,0 +1,7 @@
+package request
+
+type UpdateDelinquencyPolicyRequest struct {
+	Enabled               bool `json:"enabled"`
+	BlockAfterDaysOverdue int  `json:"block_after_days_overdue" binding:"min=0"` // Optional, 0 never blocks
+	UnblockWhenCleared    bool `json:"unblock_when_cleared"`
+}
@@ -1,9 +1,12 @@
 package request
 
+import "ApiRestFinance/internal/model/entities/enums"
+
 type UpdateEstablishmentRequest struct {
-	RUC      string `json:"ruc" binding:"required"`
-	Name     string `json:"name" binding:"required"`
-	Phone    string `json:"phone" binding:"required"`
-	Address  string `json:"address" binding:"required"`
-	IsActive bool   `json:"is_active"`
+	RUC                    string                      `json:"ruc" binding:"required"`
+	Name                   string                      `json:"name" binding:"required"`
+	Phone                  string                      `json:"phone" binding:"required"`
+	Address                string                      `json:"address" binding:"required"`
+	IsActive               bool                        `json:"is_active"`
+	PaymentAllocationOrder []enums.AllocationComponent `json:"payment_allocation_order" binding:"omitempty,dive,oneof=FEES PENALTY_INTEREST INTEREST PRINCIPAL"` // Optional, e.g. ["FEES","INTEREST","PRINCIPAL"]
 }
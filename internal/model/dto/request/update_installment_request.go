@@ -2,11 +2,12 @@ package request
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"time"
 )
 
 type UpdateInstallmentRequest struct {
 	DueDate time.Time               `json:"due_date" binding:"omitempty"`
-	Amount  float64                 `json:"amount" binding:"omitempty,gt=0"`
+	Amount  money.Money             `json:"amount" swaggertype:"number" binding:"omitempty,gt=0"`
 	Status  enums.InstallmentStatus `json:"status" binding:"omitempty"`
 }
@@ -1,5 +1,7 @@
 package request
 
+import "ApiRestFinance/internal/model/money"
+
 type UpdateLateFeeRequest struct {
-	Amount float64 `json:"amount" binding:"omitempty,gt=0"`
+	Amount money.Money `json:"amount" swaggertype:"number" binding:"omitempty,gt=0"`
 }
@@ -0,0 +1,23 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditLogResponse struct {
+	ID              uint            `json:"id"`
+	CreatedAt       time.Time       `json:"created_at"`
+	ActorUserID     uint            `json:"actor_user_id"`
+	EstablishmentID uint            `json:"establishment_id"`
+	Action          string          `json:"action"`
+	EntityType      string          `json:"entity_type"`
+	EntityID        string          `json:"entity_id"`
+	Before          json.RawMessage `json:"before" swaggertype:"object"`
+	After           json.RawMessage `json:"after" swaggertype:"object"`
+	IP              string          `json:"ip"`
+	RequestID       string          `json:"request_id"`
+	StatusCode      int             `json:"status_code"`
+	PrevHash        string          `json:"prev_hash"`
+	Hash            string          `json:"hash"`
+}
@@ -0,0 +1,7 @@
+package response
+
+type AuditVerificationResponse struct {
+	Valid    bool  `json:"valid"`
+	Checked  int64 `json:"checked"`   // Entries verified, up to the first broken one
+	BrokenAt *uint `json:"broken_at"` // First entry whose hash does not match, nil when the chain is intact
+}
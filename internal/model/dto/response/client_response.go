@@ -1,14 +1,17 @@
 package response
 
-import "time"
+import (
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
 
 type ClientResponse struct {
-	ID          uint      `json:"id"`
-	UserID      uint      `json:"user_id"`
-	Phone       string    `json:"phone"`
-	Email       string    `json:"email"`
-	CreditLimit float64   `json:"credit_limit"`
-	IsActive    bool      `json:"is_active"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uint        `json:"id"`
+	UserID      uint        `json:"user_id"`
+	Phone       string      `json:"phone"`
+	Email       string      `json:"email"`
+	CreditLimit money.Money `json:"credit_limit" swaggertype:"number"`
+	IsActive    bool        `json:"is_active"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
 }
@@ -2,25 +2,37 @@ package response
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"time"
 )
 
 type CreditAccountResponse struct {
-	ID                      uint                 `json:"id"`
-	EstablishmentID         uint                 `json:"establishment_id"`
-	ClientID                uint                 `json:"client_id"`
-	CreditLimit             float64              `json:"credit_limit"`
-	CurrentBalance          float64              `json:"current_balance"`
-	MonthlyDueDate          int                  `json:"monthly_due_date"`
-	InterestRate            float64              `json:"interest_rate"`
-	InterestType            enums.InterestType   `json:"interest_type"`
-	CreditType              enums.CreditType     `json:"credit_type"`
-	GracePeriod             int                  `json:"grace_period"`
-	IsBlocked               bool                 `json:"is_blocked"`
-	LastInterestAccrualDate time.Time            `json:"last_interest_accrual_date"`
-	CreatedAt               time.Time            `json:"created_at"`
-	UpdatedAt               time.Time            `json:"updated_at"`
-	LateFeeRuleID           uint                 `json:"late_fee_rule_id"`
-	Client                  *ClientResponse      `json:"client"`
-	LateFeeRule             *LateFeeRuleResponse `json:"late_fee_rule"`
+	ID                      uint                       `json:"id"`
+	EstablishmentID         uint                       `json:"establishment_id"`
+	ClientID                uint                       `json:"client_id"`
+	CreditLimit             money.Money                `json:"credit_limit" swaggertype:"number"`
+	CurrentBalance          money.Money                `json:"current_balance" swaggertype:"number"`
+	FeesBalance             money.Money                `json:"fees_balance" swaggertype:"number"`
+	PenaltyInterestBalance  money.Money                `json:"penalty_interest_balance" swaggertype:"number"`
+	InterestBalance         money.Money                `json:"interest_balance" swaggertype:"number"`
+	MonthlyDueDate          int                        `json:"monthly_due_date"`
+	InterestRate            float64                    `json:"interest_rate"`
+	InterestType            enums.InterestType         `json:"interest_type"`
+	PenaltyInterestRate     float64                    `json:"penalty_interest_rate"`
+	DayCount                enums.DayCountConvention   `json:"day_count"`
+	Compounding             enums.CompoundingFrequency `json:"compounding"`
+	CreditType              enums.CreditType           `json:"credit_type"`
+	Term                    int                        `json:"term"`
+	AmortizationMethod      enums.AmortizationMethod   `json:"amortization_method"`
+	GracePeriod             int                        `json:"grace_period"`
+	GraceType               enums.GraceType            `json:"grace_type"`
+	IsBlocked               bool                       `json:"is_blocked"`
+	BlockedByPolicy         bool                       `json:"blocked_by_policy"`
+	LastInterestAccrualDate time.Time                  `json:"last_interest_accrual_date"`
+	LastPenaltyAccrualDate  *time.Time                 `json:"last_penalty_accrual_date"`
+	CreatedAt               time.Time                  `json:"created_at"`
+	UpdatedAt               time.Time                  `json:"updated_at"`
+	LateFeeRuleID           uint                       `json:"late_fee_rule_id"`
+	Client                  *ClientResponse            `json:"client"`
+	LateFeeRule             *LateFeeRuleResponse       `json:"late_fee_rule"`
 }
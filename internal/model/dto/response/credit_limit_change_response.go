@@ -0,0 +1,23 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
+
+type CreditLimitChangeResponse struct {
+	ID                uint                         `json:"id"`
+	CreditAccountID   uint                         `json:"credit_account_id"`
+	RequestedByUserID uint                         `json:"requested_by_user_id"`
+	OldLimit          money.Money                  `json:"old_limit" swaggertype:"number"`
+	NewLimit          money.Money                  `json:"new_limit" swaggertype:"number"`
+	Reason            string                       `json:"reason"`
+	Automatic         bool                         `json:"automatic"`
+	Status            entities.CreditRequestStatus `json:"status"`
+	Override          bool                         `json:"override"`
+	DecidedByUserID   uint                         `json:"decided_by_user_id"`
+	DecidedAt         *time.Time                   `json:"decided_at"`
+	CreatedAt         time.Time                    `json:"created_at"`
+	UpdatedAt         time.Time                    `json:"updated_at"`
+}
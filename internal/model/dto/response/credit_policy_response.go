@@ -0,0 +1,21 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
+
+type CreditPolicyResponse struct {
+	ID                   uint        `json:"id"`
+	EstablishmentID      uint        `json:"establishment_id"`
+	Enabled              bool        `json:"enabled"`
+	AutoApproveMinScore  int         `json:"auto_approve_min_score"`
+	AutoRejectBelowScore int         `json:"auto_reject_below_score"`
+	MaxAutoApproveLimit  money.Money `json:"max_auto_approve_limit" swaggertype:"number"`
+	LimitReviewPercent   float64     `json:"limit_review_percent"`
+	MinInterestRate      float64     `json:"min_interest_rate"`
+	MaxGracePeriod       int         `json:"max_grace_period"`
+	MaxTerm              int         `json:"max_term"`
+	CreatedAt            time.Time   `json:"created_at"`
+	UpdatedAt            time.Time   `json:"updated_at"`
+}
@@ -3,6 +3,7 @@ package response
 import (
 	"ApiRestFinance/internal/model/entities"
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"time"
 )
 
@@ -10,14 +11,23 @@ type CreditRequestResponse struct {
 	ID                   uint                         `json:"id"`
 	ClientID             uint                         `json:"client_id"`
 	EstablishmentID      uint                         `json:"establishment_id"`
-	RequestedCreditLimit float64                      `json:"requested_credit_limit"`
+	RequestedCreditLimit money.Money                  `json:"requested_credit_limit" swaggertype:"number"`
 	MonthlyDueDate       int                          `json:"monthly_due_date"`
 	InterestType         enums.InterestType           `json:"interest_type"`
+	InterestRate         float64                      `json:"interest_rate"`
+	DayCount             enums.DayCountConvention     `json:"day_count"`
+	Compounding          enums.CompoundingFrequency   `json:"compounding"`
 	CreditType           enums.CreditType             `json:"credit_type"`
+	Term                 int                          `json:"term"`
+	AmortizationMethod   enums.AmortizationMethod     `json:"amortization_method"`
 	GracePeriod          int                          `json:"grace_period"`
+	GraceType            enums.GraceType              `json:"grace_type"`
 	Status               entities.CreditRequestStatus `json:"status"`
 	ApprovedAt           *time.Time                   `json:"approved_at"`
 	RejectedAt           *time.Time                   `json:"rejected_at"`
+	Score                *int                         `json:"score"`
+	Decision             enums.CreditDecision         `json:"decision"`
+	DecisionReasons      []string                     `json:"decision_reasons"`
 	CreatedAt            time.Time                    `json:"created_at"`
 	UpdatedAt            time.Time                    `json:"updated_at"`
 }
@@ -0,0 +1,13 @@
+package response
+
+import "time"
+
+type DelinquencyPolicyResponse struct {
+	ID                    uint      `json:"id"`
+	EstablishmentID       uint      `json:"establishment_id"`
+	Enabled               bool      `json:"enabled"`
+	BlockAfterDaysOverdue int       `json:"block_after_days_overdue"`
+	UnblockWhenCleared    bool      `json:"unblock_when_cleared"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
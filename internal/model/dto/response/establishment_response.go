@@ -1,18 +1,20 @@
 package response
 
 import (
+	"ApiRestFinance/internal/model/entities/enums"
 	"time"
 )
 
 type EstablishmentResponse struct {
-	ID        uint              `json:"id"`
-	RUC       string            `json:"ruc"`
-	Name      string            `json:"name"`
-	Phone     string            `json:"phone"`
-	Address   string            `json:"address"`
-	IsActive  bool              `json:"is_active"`
-	CreatedAt time.Time         `json:"created_at"`
-	UpdatedAt time.Time         `json:"updated_at"`
-	Admin     *AdminResponse    `json:"admin"`
-	Products  []ProductResponse `json:"products"`
+	ID                     uint                        `json:"id"`
+	RUC                    string                      `json:"ruc"`
+	Name                   string                      `json:"name"`
+	Phone                  string                      `json:"phone"`
+	Address                string                      `json:"address"`
+	IsActive               bool                        `json:"is_active"`
+	PaymentAllocationOrder []enums.AllocationComponent `json:"payment_allocation_order"`
+	CreatedAt              time.Time                   `json:"created_at"`
+	UpdatedAt              time.Time                   `json:"updated_at"`
+	Admin                  *AdminResponse              `json:"admin"`
+	Products               []ProductResponse           `json:"products"`
 }
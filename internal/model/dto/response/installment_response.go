@@ -2,13 +2,21 @@ package response
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"time"
 )
 
 type InstallmentResponse struct {
 	ID              uint                    `json:"id"`
 	CreditAccountID uint                    `json:"credit_account_id"`
+	Number          int                     `json:"number"`
 	DueDate         time.Time               `json:"due_date"`
-	Amount          float64                 `json:"amount"`
+	Amount          money.Money             `json:"amount" swaggertype:"number"`
+	Principal       money.Money             `json:"principal" swaggertype:"number"`
+	Interest        money.Money             `json:"interest" swaggertype:"number"`
+	Balance         money.Money             `json:"balance" swaggertype:"number"`
+	InterestPaid    money.Money             `json:"interest_paid" swaggertype:"number"`
+	PrincipalPaid   money.Money             `json:"principal_paid" swaggertype:"number"`
+	PaidAt          *time.Time              `json:"paid_at"`
 	Status          enums.InstallmentStatus `json:"status"`
 }
@@ -0,0 +1,23 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
+
+type JournalEntryResponse struct {
+	ID              uint                  `json:"id"`
+	EstablishmentID uint                  `json:"establishment_id"`
+	CreditAccountID uint                  `json:"credit_account_id"`
+	TransactionID   uint                  `json:"transaction_id"`
+	EntryDate       time.Time             `json:"entry_date"`
+	Description     string                `json:"description"`
+	Lines           []JournalLineResponse `json:"lines"`
+}
+
+type JournalLineResponse struct {
+	AccountCode string      `json:"account_code"`
+	AccountName string      `json:"account_name"`
+	Debit       money.Money `json:"debit" swaggertype:"number"`
+	Credit      money.Money `json:"credit" swaggertype:"number"`
+}
@@ -1,10 +1,13 @@
 package response
 
-import "time"
+import (
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
 
 type LateFeeResponse struct {
-	ID              uint      `json:"id"`
-	CreditAccountID uint      `json:"credit_account_id"`
-	Amount          float64   `json:"amount"`
-	AppliedDate     time.Time `json:"applied_date"`
+	ID              uint        `json:"id"`
+	CreditAccountID uint        `json:"credit_account_id"`
+	Amount          money.Money `json:"amount" swaggertype:"number"`
+	AppliedDate     time.Time   `json:"applied_date"`
 }
@@ -0,0 +1,11 @@
+package response
+
+import "ApiRestFinance/internal/model/entities/enums"
+
+type LedgerAccountResponse struct {
+	ID              uint                    `json:"id"`
+	EstablishmentID uint                    `json:"establishment_id"`
+	Code            string                  `json:"code"`
+	Name            string                  `json:"name"`
+	Type            enums.LedgerAccountType `json:"type"`
+}
@@ -0,0 +1,24 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// LedgerBalanceResponse compares the balances stored on a credit account with the ones
+// derived from its journal entries.
+type LedgerBalanceResponse struct {
+	CreditAccountID uint                      `json:"credit_account_id"`
+	CurrentBalance  money.Money               `json:"current_balance" swaggertype:"number"`
+	LedgerBalance   money.Money               `json:"ledger_balance" swaggertype:"number"`
+	Difference      money.Money               `json:"difference" swaggertype:"number"`
+	Components      []LedgerComponentResponse `json:"components"`
+	Balanced        bool                      `json:"balanced"`
+}
+
+type LedgerComponentResponse struct {
+	Component      enums.AllocationComponent `json:"component"`
+	AccountBalance money.Money               `json:"account_balance" swaggertype:"number"`
+	LedgerBalance  money.Money               `json:"ledger_balance" swaggertype:"number"`
+	Difference     money.Money               `json:"difference" swaggertype:"number"`
+}
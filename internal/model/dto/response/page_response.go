@@ -0,0 +1,10 @@
+package response
+
+// PageResponse represents a page of a list endpoint.
+type PageResponse[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int64  `json:"total"` // Items matching the filters, on every page
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	NextCursor string `json:"next_cursor,omitempty"` // Empty on the last page
+}
@@ -0,0 +1,15 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+type PaymentAllocationResponse struct {
+	ID              uint                      `json:"id"`
+	TransactionID   uint                      `json:"transaction_id"`
+	CreditAccountID uint                      `json:"credit_account_id"`
+	InstallmentID   *uint                     `json:"installment_id"`
+	Component       enums.AllocationComponent `json:"component"`
+	Amount          money.Money               `json:"amount" swaggertype:"number"`
+}
@@ -0,0 +1,19 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// PrepaymentResponse shows how a prepayment was applied and the schedule that replaces the open installments.
+type PrepaymentResponse struct {
+	TransactionID      uint                  `json:"transaction_id"`
+	Mode               enums.PrepaymentMode  `json:"mode"`
+	Amount             money.Money           `json:"amount" swaggertype:"number"`
+	InterestPaid       money.Money           `json:"interest_paid" swaggertype:"number"` // Interest earned up to the prepayment date
+	PrincipalPaid      money.Money           `json:"principal_paid" swaggertype:"number"`
+	RebatedInterest    money.Money           `json:"rebated_interest" swaggertype:"number"` // Scheduled interest no longer charged
+	RemainingPrincipal money.Money           `json:"remaining_principal" swaggertype:"number"`
+	Term               int                   `json:"term"`
+	Installments       []InstallmentResponse `json:"installments"`
+}
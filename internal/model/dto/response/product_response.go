@@ -2,6 +2,7 @@ package response
 
 import (
 	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/money"
 	"time"
 )
 
@@ -9,7 +10,7 @@ type ProductResponse struct {
 	ID            uint                     `json:"id"`
 	Name          string                   `json:"name"`
 	Description   string                   `json:"description"`
-	Price         float64                  `json:"price"`
+	Price         money.Money              `json:"price" swaggertype:"number"`
 	Category      entities.ProductCategory `json:"category"`
 	Stock         int                      `json:"stock"`
 	IsActive      bool                     `json:"is_active"`
@@ -0,0 +1,14 @@
+package response
+
+import "ApiRestFinance/internal/model/money"
+
+type PurchaseLineResponse struct {
+	ID              uint        `json:"id"`
+	TransactionID   uint        `json:"transaction_id"`
+	ProductID       uint        `json:"product_id"`
+	ProductName     string      `json:"product_name"`
+	Quantity        int         `json:"quantity"`
+	UnitPrice       money.Money `json:"unit_price" swaggertype:"number"`
+	Amount          money.Money `json:"amount" swaggertype:"number"`
+	PriceOverridden bool        `json:"price_overridden"`
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
+
+type ReconciliationResponse struct {
+	EstablishmentID uint                            `json:"establishment_id"` // 0 when every establishment was reconciled
+	DryRun          bool                            `json:"dry_run"`
+	RunAt           time.Time                       `json:"run_at"`
+	AccountsChecked int                             `json:"accounts_checked"`
+	Discrepancies   int                             `json:"discrepancies"`
+	Adjusted        int                             `json:"adjusted"`
+	Accounts        []AccountReconciliationResponse `json:"accounts"` // Only accounts with a discrepancy
+}
+
+// AccountReconciliationResponse compares the stored balance of a credit account with the
+// balances rebuilt from its history, its transactions and the ledger.
+type AccountReconciliationResponse struct {
+	CreditAccountID       uint        `json:"credit_account_id"`
+	EstablishmentID       uint        `json:"establishment_id"`
+	CurrentBalance        money.Money `json:"current_balance" swaggertype:"number"`
+	HistoryBalance        money.Money `json:"history_balance" swaggertype:"number"`
+	TransactionBalance    money.Money `json:"transaction_balance" swaggertype:"number"`
+	LedgerBalance         money.Money `json:"ledger_balance" swaggertype:"number"`
+	Difference            money.Money `json:"difference" swaggertype:"number"`             // History balance minus current balance
+	TransactionDifference money.Money `json:"transaction_difference" swaggertype:"number"` // Transaction balance minus history balance
+	LedgerDifference      money.Money `json:"ledger_difference" swaggertype:"number"`      // Ledger balance minus history balance
+	Adjusted              bool        `json:"adjusted"`
+	AdjustmentID          *uint       `json:"adjustment_id"`
+}
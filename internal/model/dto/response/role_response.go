@@ -0,0 +1,6 @@
+package response
+
+type RoleResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,27 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"time"
+)
+
+type ScheduledJobResponse struct {
+	ID             uint       `json:"id"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	CronExpression string     `json:"cron_expression"`
+	IsPaused       bool       `json:"is_paused"`
+	LastRunAt      *time.Time `json:"last_run_at"`
+	NextRunAt      *time.Time `json:"next_run_at"`
+}
+
+type JobRunResponse struct {
+	ID              uint               `json:"id"`
+	ScheduledJobID  uint               `json:"scheduled_job_id"`
+	EstablishmentID uint               `json:"establishment_id"`
+	Trigger         enums.JobTrigger   `json:"trigger"`
+	Status          enums.JobRunStatus `json:"status"`
+	StartedAt       time.Time          `json:"started_at"`
+	FinishedAt      *time.Time         `json:"finished_at"`
+	Error           string             `json:"error,omitempty"`
+}
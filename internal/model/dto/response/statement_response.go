@@ -0,0 +1,23 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
+
+type StatementResponse struct {
+	ID              uint        `json:"id"`
+	CreditAccountID uint        `json:"credit_account_id"`
+	PeriodStart     time.Time   `json:"period_start"`
+	PeriodEnd       time.Time   `json:"period_end"`
+	DueDate         time.Time   `json:"due_date"`
+	OpeningBalance  money.Money `json:"opening_balance" swaggertype:"number"`
+	Purchases       money.Money `json:"purchases" swaggertype:"number"`
+	Payments        money.Money `json:"payments" swaggertype:"number"`
+	Interest        money.Money `json:"interest" swaggertype:"number"`
+	Fees            money.Money `json:"fees" swaggertype:"number"`
+	Adjustments     money.Money `json:"adjustments" swaggertype:"number"`
+	ClosingBalance  money.Money `json:"closing_balance" swaggertype:"number"`
+	AmountDue       money.Money `json:"amount_due" swaggertype:"number"`
+	CreatedAt       time.Time   `json:"created_at"`
+}
@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// TransactionHistoryResponse represents a page of the history of a credit account, with the
+// balances and totals of the period searched.
+type TransactionHistoryResponse struct {
+	Items          []CreditAccountHistoryResponse `json:"items"`
+	Total          int64                          `json:"total"`
+	Limit          int                            `json:"limit"`
+	Offset         int                            `json:"offset"`
+	NextCursor     string                         `json:"next_cursor,omitempty"`
+	OpeningBalance money.Money                    `json:"opening_balance" swaggertype:"number"` // Balance right before the period
+	ClosingBalance money.Money                    `json:"closing_balance" swaggertype:"number"` // Balance at the end of the period
+	Totals         []TransactionTypeTotalResponse `json:"totals"`                               // Entries matching the filters, on every page
+}
+
+type CreditAccountHistoryResponse struct {
+	ID              uint                   `json:"id"`
+	CreditAccountID uint                   `json:"credit_account_id"`
+	TransactionID   *uint                  `json:"transaction_id"`
+	TransactionDate time.Time              `json:"transaction_date"`
+	TransactionType enums.TransactionType  `json:"transaction_type"`
+	Amount          money.Money            `json:"amount" swaggertype:"number"`  // Change of the balance
+	Balance         money.Money            `json:"balance" swaggertype:"number"` // Running balance after the entry
+	Description     string                 `json:"description"`
+	OldLimit        *money.Money           `json:"old_limit,omitempty" swaggertype:"number"` // Set on credit limit changes
+	NewLimit        *money.Money           `json:"new_limit,omitempty" swaggertype:"number"` // Set on credit limit changes
+	Lines           []PurchaseLineResponse `json:"lines,omitempty"`                          // Items of an itemized purchase
+}
+
+type TransactionTypeTotalResponse struct {
+	TransactionType enums.TransactionType `json:"transaction_type"`
+	Count           int64                 `json:"count"`
+	Amount          money.Money           `json:"amount" swaggertype:"number"`
+}
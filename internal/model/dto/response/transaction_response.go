@@ -2,15 +2,20 @@ package response
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"time"
 )
 
 type TransactionResponse struct {
-	ID              uint                  `json:"id"`
-	CreditAccountID uint                  `json:"credit_account_id"`
-	TransactionType enums.TransactionType `json:"transaction_type"`
-	Amount          float64               `json:"amount"`
-	Description     string                `json:"description"`
-	CreatedAt       time.Time             `json:"created_at"`
-	UpdatedAt       time.Time             `json:"updated_at"`
+	ID              uint                   `json:"id"`
+	CreditAccountID uint                   `json:"credit_account_id"`
+	TransactionType enums.TransactionType  `json:"transaction_type"`
+	Amount          money.Money            `json:"amount" swaggertype:"number"`
+	Description     string                 `json:"description"`
+	TransactionDate time.Time              `json:"transaction_date"`
+	ReversalOfID    *uint                  `json:"reversal_of_id"`
+	ReasonCode      enums.ReasonCode       `json:"reason_code"`
+	Lines           []PurchaseLineResponse `json:"lines,omitempty"` // Items of an itemized purchase
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
 }
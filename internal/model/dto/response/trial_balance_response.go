@@ -0,0 +1,25 @@
+package response
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"time"
+)
+
+type TrialBalanceResponse struct {
+	EstablishmentID uint                       `json:"establishment_id"`
+	AsOf            time.Time                  `json:"as_of"`
+	Accounts        []TrialBalanceLineResponse `json:"accounts"`
+	TotalDebit      money.Money                `json:"total_debit" swaggertype:"number"`
+	TotalCredit     money.Money                `json:"total_credit" swaggertype:"number"`
+	Balanced        bool                       `json:"balanced"`
+}
+
+type TrialBalanceLineResponse struct {
+	Code    string                  `json:"code"`
+	Name    string                  `json:"name"`
+	Type    enums.LedgerAccountType `json:"type"`
+	Debit   money.Money             `json:"debit" swaggertype:"number"`
+	Credit  money.Money             `json:"credit" swaggertype:"number"`
+	Balance money.Money             `json:"balance" swaggertype:"number"` // On the normal side of the account
+}
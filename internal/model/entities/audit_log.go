@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// ErrAuditLogImmutable is returned when an audit log entry is modified or deleted.
+var ErrAuditLogImmutable = errors.New("audit log entries are immutable")
+
+// AuditLog records a state-changing request. Every entry hashes the previous one, so editing
+// or removing an entry breaks the chain from that point on.
+type AuditLog struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	CreatedAt       time.Time `gorm:"index;not null"`
+	ActorUserID     uint      `gorm:"index"`          // 0 for anonymous requests
+	EstablishmentID uint      `gorm:"index"`          // Establishment the entity belongs to, 0 when it has none
+	Action          string    `gorm:"index;not null"` // Method and route, e.g. "PUT /api/v1/credit-accounts/:id"
+	EntityType      string    `gorm:"index;not null"`
+	EntityID        string    `gorm:"index"`
+	Before          string    `gorm:"type:jsonb;not null"` // Changed fields before the request
+	After           string    `gorm:"type:jsonb;not null"` // Changed fields after the request
+	IP              string
+	RequestID       string `gorm:"index"`
+	StatusCode      int    `gorm:"not null"`
+	PrevHash        string `gorm:"not null"`
+	Hash            string `gorm:"uniqueIndex;not null"`
+}
+
+// BeforeUpdate prevents audit log entries from being changed.
+func (a *AuditLog) BeforeUpdate(tx *gorm.DB) error {
+	return ErrAuditLogImmutable
+}
+
+// BeforeDelete prevents audit log entries from being deleted.
+func (a *AuditLog) BeforeDelete(tx *gorm.DB) error {
+	return ErrAuditLogImmutable
+}
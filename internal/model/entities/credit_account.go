@@ -2,6 +2,7 @@ package entities
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"gorm.io/gorm"
 	"time"
 )
@@ -9,22 +10,34 @@ import (
 // CreditAccount represents a client's credit account.
 type CreditAccount struct {
 	gorm.Model
-	EstablishmentID         uint               `gorm:"index;not null"`
-	ClientID                uint               `gorm:"index;not null"`
-	CreditLimit             float64            `gorm:"not null"`
-	MonthlyDueDate          int                `gorm:"not null"` // Day of the month (1-31)
-	InterestRate            float64            `gorm:"not null"`
-	InterestType            enums.InterestType `gorm:"not null"`
-	CreditType              enums.CreditType   `gorm:"not null"`
-	GracePeriod             int                `gorm:"default:0"` // In months
-	IsBlocked               bool               `gorm:"default:false"`
-	LastInterestAccrualDate time.Time          `gorm:"not null"`
-	CurrentBalance          float64            `gorm:"not null"`
-	Establishment           Establishment      `gorm:"foreignKey:EstablishmentID;references:ID"`
-	Client                  Client             `gorm:"foreignKey:ClientID;references:ID"`
-	Transactions            []Transaction      `gorm:"foreignKey:CreditAccountID;references:ID"`
-	LateFees                []LateFee          `gorm:"foreignKey:CreditAccountID;references:ID"` // New relationship
-	Installments            []Installment      `gorm:"foreignKey:CreditAccountID;references:ID"` // New relationship (for long-term credit)
-	LateFeeRuleID           uint               `gorm:"index"`                                    // Foreign key to LateFeeRule
-	LateFeeRule             *LateFeeRule       `gorm:"foreignKey:LateFeeRuleID;references:ID"`   // Relationship with LateFeeRule
+	EstablishmentID         uint                       `gorm:"index;not null"`
+	ClientID                uint                       `gorm:"index;not null"`
+	CreditLimit             money.Money                `gorm:"type:numeric(15,2);not null"`
+	MonthlyDueDate          int                        `gorm:"not null"` // Day of the month (1-31)
+	InterestRate            float64                    `gorm:"not null"`
+	InterestType            enums.InterestType         `gorm:"not null"`
+	DayCount                enums.DayCountConvention   `gorm:"not null;default:ACT_360"`
+	Compounding             enums.CompoundingFrequency `gorm:"not null;default:MONTHLY"` // Only used to convert nominal rates
+	CreditType              enums.CreditType           `gorm:"not null"`
+	Term                    int                        `gorm:"default:0"` // In months, number of installments (LONG_TERM only)
+	AmortizationMethod      enums.AmortizationMethod   `gorm:"default:FRENCH"`
+	GracePeriod             int                        `gorm:"default:0"` // In months
+	GraceType               enums.GraceType            `gorm:"default:PARTIAL"`
+	PenaltyInterestRate     float64                    `gorm:"not null;default:0"` // Annual rate charged on overdue installments, 0 disables it
+	LastPenaltyAccrualDate  *time.Time                 `gorm:"null"`               // Penalty interest is charged up to this date
+	IsBlocked               bool                       `gorm:"default:false"`
+	BlockedByPolicy         bool                       `gorm:"not null;default:false"` // Blocked by the delinquency policy, which only lifts its own blocks
+	LastInterestAccrualDate time.Time                  `gorm:"not null"`
+	CurrentBalance          money.Money                `gorm:"type:numeric(15,2);not null"`
+	// Components of CurrentBalance that are not principal, used to allocate payments
+	FeesBalance            money.Money   `gorm:"type:numeric(15,2);not null;default:0"`
+	PenaltyInterestBalance money.Money   `gorm:"type:numeric(15,2);not null;default:0"`
+	InterestBalance        money.Money   `gorm:"type:numeric(15,2);not null;default:0"`
+	Establishment          Establishment `gorm:"foreignKey:EstablishmentID;references:ID"`
+	Client                 Client        `gorm:"foreignKey:ClientID;references:ID"`
+	Transactions           []Transaction `gorm:"foreignKey:CreditAccountID;references:ID"`
+	LateFees               []LateFee     `gorm:"foreignKey:CreditAccountID;references:ID"` // New relationship
+	Installments           []Installment `gorm:"foreignKey:CreditAccountID;references:ID"` // New relationship (for long-term credit)
+	LateFeeRuleID          uint          `gorm:"index"`                                    // Foreign key to LateFeeRule
+	LateFeeRule            *LateFeeRule  `gorm:"foreignKey:LateFeeRuleID;references:ID"`   // Relationship with LateFeeRule
 }
@@ -2,16 +2,20 @@ package entities
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"time"
 )
 
 type CreditAccountHistory struct {
 	ID              uint                  `gorm:"primaryKey;autoIncrement"`
 	CreditAccountID uint                  `gorm:"index;not null"`
+	TransactionID   *uint                 `gorm:"index"` // Posting behind the entry, when there is one
 	TransactionDate time.Time             `gorm:"not null"`
 	TransactionType enums.TransactionType `gorm:"not null"`
-	Amount          float64               `gorm:"not null"`  // Amount changed (positive or negative)
-	Balance         float64               `gorm:"not null"`  // The resulting balance AFTER the event
-	Description     string                `gorm:"type:text"` // Optional description
+	Amount          money.Money           `gorm:"type:numeric(15,2);not null"` // Amount changed (positive or negative)
+	Balance         money.Money           `gorm:"type:numeric(15,2);not null"` // The resulting balance AFTER the event
+	Description     string                `gorm:"type:text"`                   // Optional description
+	OldLimit        *money.Money          `gorm:"type:numeric(15,2)"`          // Credit limit before a limit change
+	NewLimit        *money.Money          `gorm:"type:numeric(15,2)"`          // Credit limit after a limit change
 	CreditAccount   CreditAccount         `gorm:"foreignKey:CreditAccountID;references:ID"`
 }
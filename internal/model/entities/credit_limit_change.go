@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+	"time"
+)
+
+// CreditLimitChange is a request to change the credit limit of an account, made by the client,
+// by staff or by an automatic limit review. It is applied once an establishment admin approves it.
+type CreditLimitChange struct {
+	gorm.Model
+	CreditAccountID   uint                `gorm:"index;not null"`
+	RequestedByUserID uint                `gorm:"index"`                       // 0 for automatic reviews
+	OldLimit          money.Money         `gorm:"type:numeric(15,2);not null"` // Limit the change replaces
+	NewLimit          money.Money         `gorm:"type:numeric(15,2);not null"`
+	Reason            string              `gorm:"type:text"`
+	Automatic         bool                `gorm:"not null;default:false"` // Proposed by an automatic limit review
+	Status            CreditRequestStatus `gorm:"not null;default:PENDING"`
+	Override          bool                `gorm:"not null;default:false"` // Approved below the balance of the account
+	DecidedByUserID   uint
+	DecidedAt         *time.Time    `gorm:"null"`
+	CreditAccount     CreditAccount `gorm:"foreignKey:CreditAccountID;references:ID"`
+}
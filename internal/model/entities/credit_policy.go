@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+)
+
+// CreditPolicy decides the credit requests of an establishment from the score of the client.
+// Requests scoring between the two thresholds, and requests asking for terms outside the
+// bounds of the policy, are left for manual review.
+type CreditPolicy struct {
+	gorm.Model
+	EstablishmentID      uint        `gorm:"uniqueIndex;not null"`
+	Enabled              bool        `gorm:"not null;default:false"`                // Disabled policies send every request to manual review
+	AutoApproveMinScore  int         `gorm:"not null"`                              // Requests scoring at least this are approved
+	AutoRejectBelowScore int         `gorm:"not null"`                              // Requests scoring less than this are rejected
+	MaxAutoApproveLimit  money.Money `gorm:"type:numeric(15,2);not null;default:0"` // Larger requested limits need manual review, 0 for no cap
+	LimitReviewPercent   float64     `gorm:"not null;default:0"`                    // Step of the limit changes proposed by automatic reviews, 0 disables them
+	MinInterestRate      float64     `gorm:"not null;default:0"`                    // Lowest effective annual rate (TEA) approved automatically
+	MaxGracePeriod       int         `gorm:"not null;default:0"`                    // Longest grace period approved automatically, in months
+	MaxTerm              int         `gorm:"not null;default:0"`                    // Longest LONG_TERM term approved automatically, in months; 0 leaves every LONG_TERM request for manual review
+}
@@ -2,23 +2,32 @@ package entities
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"gorm.io/gorm"
 	"time"
 )
 
 type CreditRequest struct {
 	gorm.Model
-	ClientID             uint                `gorm:"index;not null"`
-	EstablishmentID      uint                `gorm:"index;not null"`
-	RequestedCreditLimit float64             `gorm:"not null"`
-	MonthlyDueDate       int                 `gorm:"not null"` // Day of the month (1-31)
-	InterestType         enums.InterestType  `gorm:"not null"`
-	InterestRate         float64             `gorm:"not null"`
-	CreditType           enums.CreditType    `gorm:"not null"`
-	GracePeriod          int                 `gorm:"default:0"` // In months
-	Status               CreditRequestStatus `gorm:"not null;default:PENDING"`
-	ApprovedAt           *time.Time          `gorm:"null"` // Timestamp when the request was approved
-	RejectedAt           *time.Time          `gorm:"null"` // Timestamp when the request was rejected
-	Client               Client              `gorm:"foreignKey:ClientID;references:ID"`
-	Establishment        Establishment       `gorm:"foreignKey:EstablishmentID;references:ID"`
+	ClientID             uint                       `gorm:"index;not null"`
+	EstablishmentID      uint                       `gorm:"index;not null"`
+	RequestedCreditLimit money.Money                `gorm:"type:numeric(15,2);not null"`
+	MonthlyDueDate       int                        `gorm:"not null"` // Day of the month (1-31)
+	InterestType         enums.InterestType         `gorm:"not null"`
+	InterestRate         float64                    `gorm:"not null"`
+	DayCount             enums.DayCountConvention   `gorm:"not null;default:ACT_360"`
+	Compounding          enums.CompoundingFrequency `gorm:"not null;default:MONTHLY"`
+	CreditType           enums.CreditType           `gorm:"not null"`
+	Term                 int                        `gorm:"default:0"` // In months, number of installments (LONG_TERM only)
+	AmortizationMethod   enums.AmortizationMethod   `gorm:"default:FRENCH"`
+	GracePeriod          int                        `gorm:"default:0"` // In months
+	GraceType            enums.GraceType            `gorm:"default:PARTIAL"`
+	Status               CreditRequestStatus        `gorm:"not null;default:PENDING"`
+	ApprovedAt           *time.Time                 `gorm:"null"` // Timestamp when the request was approved
+	RejectedAt           *time.Time                 `gorm:"null"` // Timestamp when the request was rejected
+	Score                *int                       `gorm:"null"` // Credit score of the client when the request was made
+	Decision             enums.CreditDecision       `gorm:"default:MANUAL_REVIEW"`
+	DecisionReasons      string                     `gorm:"type:text"` // One reason per line
+	Client               Client                     `gorm:"foreignKey:ClientID;references:ID"`
+	Establishment        Establishment              `gorm:"foreignKey:EstablishmentID;references:ID"`
 }
@@ -0,0 +1,13 @@
+package entities
+
+import "gorm.io/gorm"
+
+// DelinquencyPolicy blocks and unblocks the credit accounts of an establishment from their
+// arrears. It is applied by the delinquency batch job.
+type DelinquencyPolicy struct {
+	gorm.Model
+	EstablishmentID       uint `gorm:"uniqueIndex;not null"`
+	Enabled               bool `gorm:"not null;default:false"`
+	BlockAfterDaysOverdue int  `gorm:"not null;default:0"`     // Accounts overdue this many days are blocked, 0 never blocks
+	UnblockWhenCleared    bool `gorm:"not null;default:false"` // Lift the blocks of the policy once the arrears are cleared
+}
@@ -0,0 +1,11 @@
+package enums
+
+// AllocationComponent is a part of the debt a payment can be applied to.
+type AllocationComponent string
+
+const (
+	FeesComponent            AllocationComponent = "FEES"
+	PenaltyInterestComponent AllocationComponent = "PENALTY_INTEREST"
+	InterestComponent        AllocationComponent = "INTEREST"
+	PrincipalComponent       AllocationComponent = "PRINCIPAL"
+)
@@ -0,0 +1,9 @@
+package enums
+
+type AmortizationMethod string
+
+const (
+	French AmortizationMethod = "FRENCH" // Fixed payment
+	German AmortizationMethod = "GERMAN" // Fixed principal
+	Bullet AmortizationMethod = "BULLET" // Interest only, principal at maturity
+)
@@ -0,0 +1,8 @@
+package enums
+
+type CompoundingFrequency string
+
+const (
+	DailyCompounding   CompoundingFrequency = "DAILY"
+	MonthlyCompounding CompoundingFrequency = "MONTHLY"
+)
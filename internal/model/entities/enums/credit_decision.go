@@ -0,0 +1,9 @@
+package enums
+
+type CreditDecision string
+
+const (
+	AutoApproved CreditDecision = "AUTO_APPROVED"
+	AutoRejected CreditDecision = "AUTO_REJECTED"
+	ManualReview CreditDecision = "MANUAL_REVIEW" // Left PENDING for an establishment admin
+)
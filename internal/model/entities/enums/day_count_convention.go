@@ -0,0 +1,8 @@
+package enums
+
+type DayCountConvention string
+
+const (
+	Actual360 DayCountConvention = "ACT_360"
+	Actual365 DayCountConvention = "ACT_365"
+)
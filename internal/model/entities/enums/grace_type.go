@@ -0,0 +1,8 @@
+package enums
+
+type GraceType string
+
+const (
+	TotalGrace   GraceType = "TOTAL"   // No payments, interest is capitalized
+	PartialGrace GraceType = "PARTIAL" // Interest-only payments, principal is deferred
+)
@@ -3,7 +3,8 @@ package enums
 type InstallmentStatus string
 
 const (
-	Pending InstallmentStatus = "PENDING"
-	Paid    InstallmentStatus = "PAID"
-	Overdue InstallmentStatus = "OVERDUE"
+	Pending       InstallmentStatus = "PENDING"
+	Paid          InstallmentStatus = "PAID"
+	PartiallyPaid InstallmentStatus = "PARTIALLY_PAID"
+	Overdue       InstallmentStatus = "OVERDUE"
 )
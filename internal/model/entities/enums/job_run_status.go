@@ -0,0 +1,16 @@
+package enums
+
+type JobRunStatus string
+
+const (
+	JobRunning   JobRunStatus = "RUNNING"
+	JobSucceeded JobRunStatus = "SUCCEEDED"
+	JobFailed    JobRunStatus = "FAILED"
+)
+
+type JobTrigger string
+
+const (
+	ScheduledTrigger JobTrigger = "SCHEDULED"
+	ManualTrigger    JobTrigger = "MANUAL"
+)
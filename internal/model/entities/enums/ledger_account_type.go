@@ -0,0 +1,16 @@
+package enums
+
+type LedgerAccountType string
+
+const (
+	AssetAccount     LedgerAccountType = "ASSET"
+	LiabilityAccount LedgerAccountType = "LIABILITY"
+	EquityAccount    LedgerAccountType = "EQUITY"
+	RevenueAccount   LedgerAccountType = "REVENUE"
+	ExpenseAccount   LedgerAccountType = "EXPENSE"
+)
+
+// DebitNormal reports whether debits increase accounts of this type.
+func (t LedgerAccountType) DebitNormal() bool {
+	return t == AssetAccount || t == ExpenseAccount
+}
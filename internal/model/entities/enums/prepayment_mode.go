@@ -0,0 +1,9 @@
+package enums
+
+// PrepaymentMode is how the schedule of a LONG_TERM account is recalculated after a prepayment.
+type PrepaymentMode string
+
+const (
+	ReduceTerm        PrepaymentMode = "REDUCE_TERM"        // Keep the installment, pay off sooner
+	ReduceInstallment PrepaymentMode = "REDUCE_INSTALLMENT" // Keep the term, pay less per installment
+)
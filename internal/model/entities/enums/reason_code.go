@@ -0,0 +1,15 @@
+package enums
+
+// ReasonCode explains why a posted transaction was reversed or adjusted.
+type ReasonCode string
+
+const (
+	DuplicateReason       ReasonCode = "DUPLICATE"        // The same movement was posted twice
+	WrongAmountReason     ReasonCode = "WRONG_AMOUNT"     // The movement was posted with an incorrect amount
+	WrongAccountReason    ReasonCode = "WRONG_ACCOUNT"    // The movement belongs to another credit account
+	DisputeReason         ReasonCode = "DISPUTE"          // The client disputed the movement
+	FeeWaiverReason       ReasonCode = "FEE_WAIVER"       // A fee or interest charge was waived
+	ReturnedPaymentReason ReasonCode = "RETURNED_PAYMENT" // The payment bounced or was charged back
+	ReconciliationReason  ReasonCode = "RECONCILIATION"   // Posted by the reconciliation tool, never by users
+	OtherReason           ReasonCode = "OTHER"
+)
@@ -0,0 +1,14 @@
+package enums
+
+// RoleName is the name of an entities.Role. Permissions are granted per role.
+type RoleName string
+
+const (
+	PlatformAdminRole      RoleName = "platform_admin"      // Operates the platform, passes every role check
+	EstablishmentAdminRole RoleName = "establishment_admin" // Manages an establishment, its clients and its credit
+	CashierRole            RoleName = "cashier"             // Registers purchases and payments at an establishment
+	ClientRole             RoleName = "client"              // Buys on credit, default role of new users
+)
+
+// RoleNames lists every role in the permission model.
+var RoleNames = []RoleName{PlatformAdminRole, EstablishmentAdminRole, CashierRole, ClientRole}
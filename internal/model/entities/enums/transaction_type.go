@@ -5,11 +5,17 @@ type TransactionType string
 const (
 	Purchase            TransactionType = "PURCHASE"
 	Payment             TransactionType = "PAYMENT"
+	Disbursement        TransactionType = "DISBURSEMENT" // Principal of a LONG_TERM loan handed to the client
 	InterestAccrual     TransactionType = "INTEREST_ACCRUAL"
+	InterestCapitalized TransactionType = "INTEREST_CAPITALIZED" // Interest added to principal during a total grace period
+	PenaltyInterest     TransactionType = "PENALTY_INTEREST"     // Interest charged on overdue installments
 	LateFeeApplied      TransactionType = "LATE_FEE_APPLIED"
 	CreditLimitIncrease TransactionType = "CREDIT_LIMIT_INCREASE"
 	CreditLimitDecrease TransactionType = "CREDIT_LIMIT_DECREASE"
 	EarlyPayment        TransactionType = "EARLY_PAYMENT"
 	AccountBlocked      TransactionType = "ACCOUNT_BLOCKED"
 	AccountUnblocked    TransactionType = "ACCOUNT_UNBLOCKED"
+	Reversal            TransactionType = "REVERSAL"   // Cancels a posted transaction, see Transaction.ReversalOfID
+	Adjustment          TransactionType = "ADJUSTMENT" // Manual correction of the balance, positive or negative
+	WriteOff            TransactionType = "WRITE_OFF"  // Uncollectible balance charged to bad debt
 )
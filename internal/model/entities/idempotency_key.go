@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// IdempotencyKey stores the response of a money-moving request so retries with the
+// same Idempotency-Key header replay it instead of moving money twice.
+type IdempotencyKey struct {
+	gorm.Model
+	Scope        string `gorm:"uniqueIndex:idx_idempotency_scope_key;not null"` // Method, route and user the key belongs to
+	Key          string `gorm:"uniqueIndex:idx_idempotency_scope_key;not null"`
+	RequestHash  string `gorm:"not null"` // SHA-256 of the request path and body
+	StatusCode   int    `gorm:"default:0"`
+	ContentType  string
+	ResponseBody []byte
+	CompletedAt  *time.Time `gorm:"null"` // Nil while the original request is still in flight
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"gorm.io/gorm"
 	"time"
 )
@@ -9,8 +10,15 @@ import (
 type Installment struct {
 	gorm.Model
 	CreditAccountID uint                    `gorm:"index;not null"`
+	Number          int                     `gorm:"default:0"` // Position in the amortization schedule
 	DueDate         time.Time               `gorm:"not null"`
-	Amount          float64                 `gorm:"not null"`
+	Amount          money.Money             `gorm:"type:numeric(15,2);not null"`
+	Principal       money.Money             `gorm:"type:numeric(15,2);not null;default:0"`
+	Interest        money.Money             `gorm:"type:numeric(15,2);not null;default:0"`
+	Balance         money.Money             `gorm:"type:numeric(15,2);not null;default:0"` // Outstanding principal after this installment
+	InterestPaid    money.Money             `gorm:"type:numeric(15,2);not null;default:0"`
+	PrincipalPaid   money.Money             `gorm:"type:numeric(15,2);not null;default:0"`
+	PaidAt          *time.Time              `gorm:"null"` // When the installment was fully paid
 	Status          enums.InstallmentStatus `gorm:"not null"`
 	CreditAccount   CreditAccount           `gorm:"foreignKey:CreditAccountID;references:ID"`
 }
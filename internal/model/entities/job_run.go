@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"gorm.io/gorm"
+	"time"
+)
+
+// JobRun records the outcome of a scheduled job for one establishment.
+type JobRun struct {
+	gorm.Model
+	ScheduledJobID  uint               `gorm:"index;not null"`
+	EstablishmentID uint               `gorm:"index;not null"`
+	Trigger         enums.JobTrigger   `gorm:"not null"`
+	Status          enums.JobRunStatus `gorm:"not null"`
+	StartedAt       time.Time          `gorm:"not null"`
+	FinishedAt      *time.Time         `gorm:"null"`
+	Error           string             `gorm:"type:text"`
+}
@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// ErrJournalImmutable is returned when a posted journal entry or one of its lines is modified or deleted.
+var ErrJournalImmutable = errors.New("journal entries are immutable once posted")
+
+// JournalEntry is the balanced double-entry record of a transaction.
+type JournalEntry struct {
+	gorm.Model
+	EstablishmentID uint          `gorm:"index;not null"`
+	CreditAccountID uint          `gorm:"index;not null"`
+	TransactionID   uint          `gorm:"uniqueIndex;not null"`
+	EntryDate       time.Time     `gorm:"index;not null"`
+	Description     string        `gorm:"type:text"`
+	Lines           []JournalLine `gorm:"foreignKey:JournalEntryID;references:ID"`
+	Transaction     Transaction   `gorm:"foreignKey:TransactionID;references:ID"`
+}
+
+// JournalLine is a debit or a credit to a ledger account.
+type JournalLine struct {
+	ID              uint          `gorm:"primaryKey;autoIncrement"`
+	JournalEntryID  uint          `gorm:"index;not null"`
+	LedgerAccountID uint          `gorm:"index;not null"`
+	Debit           money.Money   `gorm:"type:numeric(15,2);not null;default:0"`
+	Credit          money.Money   `gorm:"type:numeric(15,2);not null;default:0"`
+	LedgerAccount   LedgerAccount `gorm:"foreignKey:LedgerAccountID;references:ID"`
+}
+
+// BeforeUpdate prevents posted journal entries from being changed.
+func (e *JournalEntry) BeforeUpdate(tx *gorm.DB) error {
+	return ErrJournalImmutable
+}
+
+// BeforeDelete prevents posted journal entries from being deleted.
+func (e *JournalEntry) BeforeDelete(tx *gorm.DB) error {
+	return ErrJournalImmutable
+}
+
+// BeforeUpdate prevents journal lines from being changed.
+func (l *JournalLine) BeforeUpdate(tx *gorm.DB) error {
+	return ErrJournalImmutable
+}
+
+// BeforeDelete prevents journal lines from being deleted.
+func (l *JournalLine) BeforeDelete(tx *gorm.DB) error {
+	return ErrJournalImmutable
+}
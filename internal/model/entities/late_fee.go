@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"ApiRestFinance/internal/model/money"
 	"gorm.io/gorm"
 	"time"
 )
@@ -8,7 +9,7 @@ import (
 type LateFee struct {
 	gorm.Model
 	CreditAccountID uint          `gorm:"index;not null"`
-	Amount          float64       `gorm:"not null"`
+	Amount          money.Money   `gorm:"type:numeric(15,2);not null"`
 	AppliedDate     time.Time     `gorm:"not null"`
 	CreditAccount   CreditAccount `gorm:"foreignKey:CreditAccountID;references:ID"`
 }
@@ -0,0 +1,16 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"gorm.io/gorm"
+)
+
+// LedgerAccount is an account of the chart of accounts of an establishment.
+type LedgerAccount struct {
+	gorm.Model
+	EstablishmentID uint                    `gorm:"uniqueIndex:idx_ledger_account_code;not null"`
+	Code            string                  `gorm:"uniqueIndex:idx_ledger_account_code;not null"`
+	Name            string                  `gorm:"not null"`
+	Type            enums.LedgerAccountType `gorm:"not null"`
+	Establishment   Establishment           `gorm:"foreignKey:EstablishmentID;references:ID"`
+}
@@ -0,0 +1,16 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// PasswordResetToken is a single-use token emailed to reset a forgotten password.
+type PasswordResetToken struct {
+	gorm.Model
+	UserID    uint       `gorm:"index;not null"`
+	TokenHash string     `gorm:"uniqueIndex;not null"` // SHA-256 of the emailed token, the token itself is never stored
+	ExpiresAt time.Time  `gorm:"not null"`
+	UsedAt    *time.Time `gorm:"null"` // Set when the token is used or superseded by a newer one
+	User      User       `gorm:"foreignKey:UserID;references:ID"`
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+)
+
+// PaymentAllocation records the part of a payment applied to one debt component,
+// and to one installment when the account is LONG_TERM.
+type PaymentAllocation struct {
+	gorm.Model
+	TransactionID   uint                      `gorm:"index;not null"`
+	CreditAccountID uint                      `gorm:"index;not null"`
+	InstallmentID   *uint                     `gorm:"index"`
+	Component       enums.AllocationComponent `gorm:"not null"`
+	Amount          money.Money               `gorm:"type:numeric(15,2);not null"`
+}
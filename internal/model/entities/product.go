@@ -3,6 +3,7 @@ package entities
 import (
 	"time"
 
+	"ApiRestFinance/internal/model/money"
 	"gorm.io/gorm"
 )
 
@@ -10,7 +11,7 @@ type Product struct {
 	gorm.Model
 	Name            string          `gorm:"not null"`
 	Description     string          `gorm:"not null"`
-	Price           float64         `gorm:"not null"`
+	Price           money.Money     `gorm:"type:numeric(15,2);not null"`
 	Category        ProductCategory `gorm:"not null"`
 	Stock           int             `gorm:"not null"`
 	IsActive        bool            `gorm:"not null"`
@@ -0,0 +1,21 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+)
+
+// PurchaseLine is one product sold in a PURCHASE transaction. The product name and unit price
+// are copied at the time of the sale so later catalog changes do not alter past purchases.
+type PurchaseLine struct {
+	gorm.Model
+	TransactionID   uint        `gorm:"index;not null"`
+	CreditAccountID uint        `gorm:"index;not null"`
+	ProductID       uint        `gorm:"index;not null"`
+	ProductName     string      `gorm:"not null"`
+	Quantity        int         `gorm:"not null"`
+	UnitPrice       money.Money `gorm:"type:numeric(15,2);not null"`
+	Amount          money.Money `gorm:"type:numeric(15,2);not null"` // Quantity times UnitPrice
+	PriceOverridden bool        `gorm:"not null;default:false"`      // UnitPrice differs from the catalog price
+	Product         Product     `gorm:"foreignKey:ProductID;references:ID"`
+}
@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// RefreshToken is an issued refresh token. Tokens issued by rotation share the family of the
+// login that started the session, so reusing a rotated token revokes the whole session.
+type RefreshToken struct {
+	gorm.Model
+	UserID       uint       `gorm:"index;not null"`
+	FamilyID     string     `gorm:"index;not null"`
+	TokenHash    string     `gorm:"uniqueIndex;not null"` // SHA-256 of the token ID, the token itself is never stored
+	ExpiresAt    time.Time  `gorm:"not null"`
+	RevokedAt    *time.Time `gorm:"null"` // Set on rotation, logout and reuse detection
+	ReplacedByID *uint      `gorm:"null"` // Token issued when this one was rotated
+	User         User       `gorm:"foreignKey:UserID;references:ID"`
+}
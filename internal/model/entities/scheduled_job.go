@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// ScheduledJob is a batch run periodically by the in-process scheduler.
+type ScheduledJob struct {
+	gorm.Model
+	Name           string     `gorm:"uniqueIndex;not null"`
+	Description    string     `gorm:"type:text"`
+	CronExpression string     `gorm:"not null"`
+	IsPaused       bool       `gorm:"default:false"`
+	LastRunAt      *time.Time `gorm:"null"`
+	Runs           []JobRun   `gorm:"foreignKey:ScheduledJobID;references:ID"`
+}
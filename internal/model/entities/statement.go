@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"ApiRestFinance/internal/model/money"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// ErrStatementImmutable is returned when a closed statement is modified or deleted.
+var ErrStatementImmutable = errors.New("statements are immutable once closed")
+
+// Statement is the closed billing cycle of a credit account. Totals are a snapshot
+// of the CreditAccountHistory rows dated inside the period.
+type Statement struct {
+	gorm.Model
+	CreditAccountID uint          `gorm:"uniqueIndex:idx_statement_period;not null"`
+	PeriodStart     time.Time     `gorm:"not null"`                                  // First day of the cycle
+	PeriodEnd       time.Time     `gorm:"uniqueIndex:idx_statement_period;not null"` // Closing date, inclusive
+	DueDate         time.Time     `gorm:"not null"`
+	OpeningBalance  money.Money   `gorm:"type:numeric(15,2);not null"`
+	Purchases       money.Money   `gorm:"type:numeric(15,2);not null"`
+	Payments        money.Money   `gorm:"type:numeric(15,2);not null"` // Positive amount paid in the cycle
+	Interest        money.Money   `gorm:"type:numeric(15,2);not null"`
+	Fees            money.Money   `gorm:"type:numeric(15,2);not null"`
+	Adjustments     money.Money   `gorm:"type:numeric(15,2);not null"` // Any other movement
+	ClosingBalance  money.Money   `gorm:"type:numeric(15,2);not null"`
+	AmountDue       money.Money   `gorm:"type:numeric(15,2);not null"`
+	CreditAccount   CreditAccount `gorm:"foreignKey:CreditAccountID;references:ID"`
+}
+
+// BeforeUpdate prevents closed statements from being changed.
+func (s *Statement) BeforeUpdate(tx *gorm.DB) error {
+	return ErrStatementImmutable
+}
+
+// BeforeDelete prevents closed statements from being deleted.
+func (s *Statement) BeforeDelete(tx *gorm.DB) error {
+	return ErrStatementImmutable
+}
@@ -2,18 +2,36 @@ package entities
 
 import (
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"errors"
 	"gorm.io/gorm"
 	"time"
 )
 
+// ErrTransactionImmutable is returned when a posted transaction is modified or deleted.
+// Corrections are posted as a REVERSAL or an ADJUSTMENT instead.
+var ErrTransactionImmutable = errors.New("transactions are immutable once posted")
+
 type Transaction struct {
 	gorm.Model
 	CreditAccountID uint                  `gorm:"index"`    // Now optional
 	RecipientType   enums.RecipientType   `gorm:"not null"` // New field to indicate recipient type
 	RecipientID     uint                  `gorm:"not null"` // ID of the recipient
 	TransactionType enums.TransactionType `gorm:"not null"`
-	Amount          float64               `gorm:"not null"`
-	Description     string                `gorm:"type:text"` // Optional description
-	TransactionDate time.Time             `gorm:"not null"`  // Date of the transaction
+	Amount          money.Money           `gorm:"type:numeric(15,2);not null"` // Signed only for ADJUSTMENT
+	Description     string                `gorm:"type:text"`                   // Optional description
+	TransactionDate time.Time             `gorm:"not null"`                    // Date of the transaction
+	ReversalOfID    *uint                 `gorm:"uniqueIndex"`                 // Transaction cancelled by this REVERSAL
+	ReasonCode      enums.ReasonCode      `gorm:"default:''"`                  // Set on REVERSAL and ADJUSTMENT
 	CreditAccount   CreditAccount         `gorm:"foreignKey:CreditAccountID;references:ID"`
 }
+
+// BeforeUpdate prevents posted transactions from being changed.
+func (t *Transaction) BeforeUpdate(tx *gorm.DB) error {
+	return ErrTransactionImmutable
+}
+
+// BeforeDelete prevents posted transactions from being deleted.
+func (t *Transaction) BeforeDelete(tx *gorm.DB) error {
+	return ErrTransactionImmutable
+}
@@ -6,10 +6,11 @@ import (
 
 type User struct {
 	gorm.Model
-	Email    string `gorm:"uniqueIndex;not null"`
-	Password string `gorm:"not null"`
-	Name     string
-	Roles    []Role  `gorm:"many2many:user_roles;"`
-	Client   *Client `gorm:"foreignKey:UserID;references:ID"`
-	Admin    *Admin  `gorm:"foreignKey:UserID;references:ID"`
+	Email           string `gorm:"uniqueIndex;not null"`
+	Password        string `gorm:"not null"`
+	Name            string
+	EstablishmentID *uint   `gorm:"index"` // Establishment a cashier works at, admins use Admin.EstablishmentID
+	Roles           []Role  `gorm:"many2many:user_roles;"`
+	Client          *Client `gorm:"foreignKey:UserID;references:ID"`
+	Admin           *Admin  `gorm:"foreignKey:UserID;references:ID"`
 }
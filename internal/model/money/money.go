@@ -0,0 +1,315 @@
+// Package money provides an exact monetary amount type used across entities and DTOs.
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount expressed in céntimos (1/100 of a sol).
+//
+// Amounts are stored as integers so that sums and differences are always exact.
+// Whenever a value has to be brought back to cent precision (parsing input with
+// more than two decimals, converting a float, applying a rate) it is rounded
+// half away from zero, which is the commercial rounding used for statements.
+type Money int64
+
+// Zero is the zero amount.
+const Zero Money = 0
+
+// ErrInvalidAmount is returned when a value cannot be parsed as an amount.
+var ErrInvalidAmount = errors.New("invalid monetary amount")
+
+// decimalLiteral matches plain decimal numbers; fractions and exponents are not amounts.
+var decimalLiteral = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// FromCents builds an amount from a number of céntimos.
+func FromCents(cents int64) Money {
+	return Money(cents)
+}
+
+// FromFloat converts a float to the nearest cent, rounding half away from zero.
+// It is meant for rates and legacy values, never for accumulating balances.
+// NaN and infinities, which have no amount, convert to zero like in MulRate, and
+// floats beyond the range of Money are clamped to its bounds.
+func FromFloat(value float64) Money {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Zero
+	}
+	return fromRat(new(big.Rat).SetFloat64(value))
+}
+
+// Parse reads a decimal string such as "1250", "-3.5" or "10.005".
+// Extra decimals are rounded half away from zero. Fractions such as "1/3",
+// exponents and amounts too large to count in céntimos are rejected.
+func Parse(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Zero, ErrInvalidAmount
+	}
+	if !decimalLiteral.MatchString(value) {
+		return Zero, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	rat, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return Zero, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	cents := roundCents(rat)
+	if !cents.IsInt64() {
+		return Zero, fmt.Errorf("%w: %q is out of range", ErrInvalidAmount, value)
+	}
+	return Money(cents.Int64()), nil
+}
+
+// MustParse is like Parse but panics on error. Intended for constants.
+func MustParse(value string) Money {
+	m, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Cents returns the amount in céntimos.
+func (m Money) Cents() int64 {
+	return int64(m)
+}
+
+// Float64 returns the amount in soles as a float, for display or ratios only.
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+// Add returns m + other.
+func (m Money) Add(other Money) Money {
+	return m + other
+}
+
+// Sub returns m - other.
+func (m Money) Sub(other Money) Money {
+	return m - other
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return -m
+}
+
+// Abs returns the absolute value of m.
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// IsZero reports whether m is zero.
+func (m Money) IsZero() bool {
+	return m == 0
+}
+
+// IsPositive reports whether m is greater than zero.
+func (m Money) IsPositive() bool {
+	return m > 0
+}
+
+// IsNegative reports whether m is lower than zero.
+func (m Money) IsNegative() bool {
+	return m < 0
+}
+
+// Min returns the smaller of m and other.
+func (m Money) Min(other Money) Money {
+	if other < m {
+		return other
+	}
+	return m
+}
+
+// Max returns the larger of m and other.
+func (m Money) Max(other Money) Money {
+	if other > m {
+		return other
+	}
+	return m
+}
+
+// MulRate multiplies the amount by a rate (e.g. 0.035 for 3.5%) and rounds the
+// result to the cent, half away from zero.
+func (m Money) MulRate(rate float64) Money {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return Zero
+	}
+	product := new(big.Rat).SetFloat64(rate)
+	product.Mul(product, new(big.Rat).SetInt64(int64(m)))
+	return fromRat(product.Quo(product, big.NewRat(100, 1)))
+}
+
+// Percent returns pct percent of the amount, rounded to the cent.
+func (m Money) Percent(pct float64) Money {
+	return m.MulRate(pct / 100)
+}
+
+// Mul multiplies the amount by an integer quantity.
+func (m Money) Mul(quantity int64) Money {
+	return m * Money(quantity)
+}
+
+// Div divides the amount by n, rounding half away from zero.
+func (m Money) Div(n int64) Money {
+	if n == 0 {
+		return Zero
+	}
+	return fromRat(big.NewRat(int64(m), n*100))
+}
+
+// Split divides the amount into n parts that add up exactly to m. Leftover
+// céntimos are assigned to the first parts.
+func (m Money) Split(n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+	parts := make([]Money, n)
+	base := int64(m) / int64(n)
+	remainder := int64(m) % int64(n)
+	for i := range parts {
+		parts[i] = Money(base)
+		if remainder > 0 {
+			parts[i]++
+			remainder--
+		} else if remainder < 0 {
+			parts[i]--
+			remainder++
+		}
+	}
+	return parts
+}
+
+// String formats the amount with exactly two decimals, e.g. "-12.05".
+func (m Money) String() string {
+	cents := int64(m)
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON encodes the amount as a JSON number with two decimals.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a quoted decimal string. The value is
+// parsed from its decimal text, so no binary floating point rounding happens.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if text == "null" {
+		return nil
+	}
+	if strings.HasPrefix(text, `"`) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		text = s
+	}
+	parsed, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// UnmarshalParam parses a query or form parameter, letting gin bind amounts from
+// their decimal text like UnmarshalJSON does.
+func (m *Money) UnmarshalParam(param string) error {
+	parsed, err := Parse(param)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value stores the amount as a decimal string so NUMERIC columns keep it exact.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan reads NUMERIC, integer and (legacy) floating point columns.
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = Zero
+		return nil
+	case []byte:
+		parsed, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case int64:
+		if v > math.MaxInt64/100 || v < math.MinInt64/100 {
+			return fmt.Errorf("%w: %d is out of range", ErrInvalidAmount, v)
+		}
+		*m = Money(v * 100)
+	case float64:
+		parsed, err := Parse(strconv.FormatFloat(v, 'f', -1, 64))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+	return nil
+}
+
+// fromRat rounds an amount in soles to the nearest cent, half away from zero. Amounts
+// beyond the range of Money are clamped to its bounds.
+func fromRat(soles *big.Rat) Money {
+	cents := roundCents(soles)
+	switch {
+	case cents.IsInt64():
+		return Money(cents.Int64())
+	case cents.Sign() > 0:
+		return Money(math.MaxInt64)
+	default:
+		return Money(math.MinInt64)
+	}
+}
+
+// roundCents returns the number of céntimos nearest to an amount in soles, half away from zero.
+func roundCents(soles *big.Rat) *big.Int {
+	cents := new(big.Rat).Mul(soles, big.NewRat(100, 1))
+	num := new(big.Int).Set(cents.Num())
+	den := cents.Denom()
+
+	negative := num.Sign() < 0
+	num.Abs(num)
+
+	quotient, remainder := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(remainder, big.NewInt(2)).Cmp(den) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if negative {
+		quotient.Neg(quotient)
+	}
+	return quotient
+}
@@ -0,0 +1,256 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Money
+	}{
+		{"1250", 125000},
+		{"-3.5", -350},
+		{"+1", 100},
+		{" 7.25 ", 725},
+		{"0.00", 0},
+		{"10.005", 1001},
+		{"10.004", 1000},
+		{"-10.005", -1001},
+		{"0.0049", 0},
+		{"92233720368547758.07", math.MaxInt64},
+		{"-92233720368547758.08", math.MinInt64},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.value)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseRejectsInvalidAmounts(t *testing.T) {
+	for _, value := range []string{"", "  ", "abc", "1/3", "1e3", "1E-2", "1e1000000", "0x10", ".5", "1.", "1,50", "--1", "NaN", "Inf", "92233720368547758.08", "-92233720368547758.09"} {
+		if _, err := Parse(value); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidAmount", value, err)
+		}
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  Money
+	}{
+		{12.5, 1250},
+		{0.125, 13},
+		{-0.125, -13},
+		{2.675, 267}, // 2.675 is stored as 2.67499999...
+		{math.NaN(), Zero},
+		{math.Inf(1), Zero},
+		{math.Inf(-1), Zero},
+		{1e300, math.MaxInt64},
+		{-1e300, math.MinInt64},
+	}
+	for _, tt := range tests {
+		if got := FromFloat(tt.value); got != tt.want {
+			t.Errorf("FromFloat(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMulRateAndPercent(t *testing.T) {
+	tests := []struct {
+		amount Money
+		rate   float64
+		want   Money
+	}{
+		{10000, 0.035, 350},
+		{333, 0.5, 167},
+		{-333, 0.5, -167},
+		{10000, 0, 0},
+		{10000, math.NaN(), Zero},
+		{10000, math.Inf(1), Zero},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.MulRate(tt.rate); got != tt.want {
+			t.Errorf("%d.MulRate(%v) = %d, want %d", tt.amount, tt.rate, got, tt.want)
+		}
+	}
+
+	if got := Money(12345).Percent(10); got != 1235 {
+		t.Errorf("Percent(10) of 123.45 = %d, want 1235", got)
+	}
+	if got := Money(12345).Percent(0); got != 0 {
+		t.Errorf("Percent(0) of 123.45 = %d, want 0", got)
+	}
+}
+
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		amount Money
+		n      int64
+		want   Money
+	}{
+		{1000, 3, 333},
+		{1001, 2, 501},
+		{-1001, 2, -501},
+		{1000, 0, Zero},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.Div(tt.n); got != tt.want {
+			t.Errorf("%d.Div(%d) = %d, want %d", tt.amount, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		amount Money
+		n      int
+		want   []Money
+	}{
+		{1000, 3, []Money{334, 333, 333}},
+		{-1000, 3, []Money{-334, -333, -333}},
+		{2, 4, []Money{1, 1, 0, 0}},
+		{900, 1, []Money{900}},
+		{900, 0, nil},
+	}
+	for _, tt := range tests {
+		got := tt.amount.Split(tt.n)
+		if len(got) != len(tt.want) {
+			t.Errorf("%d.Split(%d) = %v, want %v", tt.amount, tt.n, got, tt.want)
+			continue
+		}
+		var total Money
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%d.Split(%d) = %v, want %v", tt.amount, tt.n, got, tt.want)
+				break
+			}
+			total += got[i]
+		}
+		if tt.n > 0 && total != tt.amount {
+			t.Errorf("%d.Split(%d) adds up to %d", tt.amount, tt.n, total)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := map[Money]string{
+		0:     "0.00",
+		5:     "0.05",
+		-5:    "-0.05",
+		1205:  "12.05",
+		-1205: "-12.05",
+	}
+	for amount, want := range tests {
+		if got := amount.String(); got != want {
+			t.Errorf("Money(%d).String() = %q, want %q", int64(amount), got, want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want Money
+	}{
+		{`12.5`, 1250},
+		{`"12.5"`, 1250},
+		{`-0.01`, -1},
+		{`100`, 10000},
+	}
+	for _, tt := range tests {
+		var got Money
+		if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", tt.data, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Unmarshal(%s) = %d, want %d", tt.data, got, tt.want)
+		}
+
+		data, err := json.Marshal(got)
+		if err != nil {
+			t.Errorf("Marshal(%d) returned error: %v", got, err)
+			continue
+		}
+		var back Money
+		if err := json.Unmarshal(data, &back); err != nil || back != got {
+			t.Errorf("round trip of %s gave %d (%v), want %d", data, back, err, got)
+		}
+	}
+
+	var kept Money = 700
+	if err := json.Unmarshal([]byte(`null`), &kept); err != nil || kept != 700 {
+		t.Errorf("Unmarshal(null) = %d (%v), want the value left unchanged", kept, err)
+	}
+	for _, data := range []string{`"1/3"`, `1e3`, `true`, `"abc"`} {
+		var got Money
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("Unmarshal(%s) = %d, want an error", data, got)
+		}
+	}
+}
+
+func TestValueAndScan(t *testing.T) {
+	value, err := Money(-1205).Value()
+	if err != nil || value != "-12.05" {
+		t.Errorf("Value() = %v (%v), want -12.05", value, err)
+	}
+
+	tests := []struct {
+		src  interface{}
+		want Money
+	}{
+		{nil, Zero},
+		{[]byte("12.05"), 1205},
+		{"-12.05", -1205},
+		{int64(12), 1200},
+		{int64(-3), -300},
+		{12.05, 1205},
+		{0.1 + 0.2, 30},
+	}
+	for _, tt := range tests {
+		var got Money = 999
+		if err := got.Scan(tt.src); err != nil {
+			t.Errorf("Scan(%#v) returned error: %v", tt.src, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Scan(%#v) = %d, want %d", tt.src, got, tt.want)
+		}
+	}
+
+	for _, src := range []interface{}{int64(math.MaxInt64), int64(math.MinInt64), "1/3", true, math.Inf(1)} {
+		var got Money
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) = %d, want an error", src, got)
+		}
+	}
+
+	var scanned Money
+	if err := scanned.Scan(value); err != nil || scanned != -1205 {
+		t.Errorf("Scan(Value()) = %d (%v), want -1205", scanned, err)
+	}
+}
+
+func TestUnmarshalParam(t *testing.T) {
+	var got Money
+	if err := got.UnmarshalParam("12.50"); err != nil || got != 1250 {
+		t.Errorf("UnmarshalParam(12.50) = %d (%v), want 1250", got, err)
+	}
+	for _, param := range []string{"", "NaN", "Inf", "1e3", "abc"} {
+		if err := got.UnmarshalParam(param); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("UnmarshalParam(%q) error = %v, want ErrInvalidAmount", param, err)
+		}
+	}
+}
@@ -1,14 +1,24 @@
 package repository
 
 import (
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
 	"gorm.io/gorm"
 )
 
+// adminListSpec describes the list queries on admins.
+var adminListSpec = listSpec{
+	table:       "admins",
+	sorts:       map[string]string{"id": "id", "created_at": "created_at"},
+	defaultSort: "id",
+	date:        "created_at",
+}
+
 type AdminRepository interface {
 	CreateAdmin(admin *entities.Admin) error
 	GetAdminByUserID(userID uint) (*entities.Admin, error)
-	GetAllAdmins() ([]entities.Admin, error)
+	GetAllAdmins(query request.ListQuery) (*response.PageResponse[entities.Admin], error)
 	GetAdminByID(adminID uint) (*entities.Admin, error)
 	UpdateAdmin(admin *entities.Admin) error
 	DeleteAdmin(adminID uint) error
@@ -35,13 +45,8 @@ func (r *adminRepository) GetAdminByUserID(userID uint) (*entities.Admin, error)
 	return &admin, nil
 }
 
-func (r *adminRepository) GetAllAdmins() ([]entities.Admin, error) {
-	var admins []entities.Admin
-	err := r.db.Find(&admins).Error
-	if err != nil {
-		return nil, err
-	}
-	return admins, nil
+func (r *adminRepository) GetAllAdmins(query request.ListQuery) (*response.PageResponse[entities.Admin], error) {
+	return paginate[entities.Admin](r.db, query, adminListSpec)
 }
 
 func (r *adminRepository) GetAdminByID(adminID uint) (*entities.Admin, error) {
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/audit"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"gorm.io/gorm"
+)
+
+// auditChainLockKey is the advisory lock that serializes appends to the hash chain.
+const auditChainLockKey = 7_461_736_173
+
+// auditLogListSpec describes the list queries on the audit log.
+var auditLogListSpec = listSpec{
+	table:       "audit_logs",
+	sorts:       map[string]string{"id": "id", "created_at": "created_at"},
+	defaultSort: "-id",
+	date:        "created_at",
+}
+
+// AuditLogRepository defines the interface for audit log repository operations.
+type AuditLogRepository interface {
+	Append(entry *entities.AuditLog) error
+	Snapshot(table, id string) (map[string]interface{}, error)
+	List(query request.AuditLogQuery, tenant Tenant) (*response.PageResponse[response.AuditLogResponse], error)
+	Verify() (*response.AuditVerificationResponse, error)
+}
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository creates a new instance of auditLogRepository.
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+// Append chains an entry to the last one and stores it.
+func (r *auditLogRepository) Append(entry *entities.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", auditChainLockKey).Error; err != nil {
+			return fmt.Errorf("error locking audit log: %w", err)
+		}
+
+		var last entities.AuditLog
+		err := tx.Order("id DESC").Select("hash").Take(&last).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("error retrieving last audit log entry: %w", err)
+		}
+
+		entry.PrevHash = last.Hash
+		entry.CreatedAt = time.Now().UTC().Truncate(time.Microsecond) // Precision of the database
+		entry.Hash = audit.Hash(entry)
+		if err := tx.Create(entry).Error; err != nil {
+			return fmt.Errorf("error creating audit log entry: %w", err)
+		}
+		return nil
+	})
+}
+
+// Snapshot returns a row of a table as a map, or nil when the row does not exist.
+func (r *auditLogRepository) Snapshot(table, id string) (map[string]interface{}, error) {
+	row := map[string]interface{}{}
+	err := r.db.Table(table).Where("id = ?", id).Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s %s: %w", table, id, err)
+	}
+	return row, nil
+}
+
+// List retrieves a page of the entries matching a query, newest first unless the query sorts
+// them otherwise. Staff only see the
+// entries of their establishment and clients their own actions.
+func (r *auditLogRepository) List(query request.AuditLogQuery, tenant Tenant) (*response.PageResponse[response.AuditLogResponse], error) {
+	db := r.db
+	if tenant.EstablishmentID != 0 {
+		db = db.Where("establishment_id = ?", tenant.EstablishmentID)
+	} else if tenant.ClientUserID != 0 {
+		db = db.Where("actor_user_id = ?", tenant.ClientUserID)
+	} else if query.EstablishmentID != 0 {
+		db = db.Where("establishment_id = ?", query.EstablishmentID)
+	}
+	if query.ActorUserID != 0 {
+		db = db.Where("actor_user_id = ?", query.ActorUserID)
+	}
+	if query.Action != "" {
+		db = db.Where("action = ?", query.Action)
+	}
+	if query.EntityType != "" {
+		db = db.Where("entity_type = ?", query.EntityType)
+	}
+	if query.EntityID != "" {
+		db = db.Where("entity_id = ?", query.EntityID)
+	}
+
+	page, err := paginate[entities.AuditLog](db, query.ListQuery, auditLogListSpec)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving audit log entries: %w", err)
+	}
+	return mapPage(page, getAuditLogResponse), nil
+}
+
+// Verify recomputes the hash chain from the first entry and stops at the first mismatch.
+func (r *auditLogRepository) Verify() (*response.AuditVerificationResponse, error) {
+	result := &response.AuditVerificationResponse{Valid: true}
+	prevHash := ""
+
+	var entries []entities.AuditLog
+	err := r.db.Order("id").FindInBatches(&entries, 500, func(tx *gorm.DB, batch int) error {
+		for i := range entries {
+			entry := &entries[i]
+			if entry.PrevHash != prevHash || audit.Hash(entry) != entry.Hash {
+				result.Valid = false
+				result.BrokenAt = &entry.ID
+				return errStopVerification
+			}
+			prevHash = entry.Hash
+			result.Checked++
+		}
+		return nil
+	}).Error
+	if err != nil && !errors.Is(err, errStopVerification) {
+		return nil, fmt.Errorf("error verifying audit log: %w", err)
+	}
+	return result, nil
+}
+
+// errStopVerification ends the batch walk of Verify at the first broken entry.
+var errStopVerification = errors.New("audit chain broken")
+
+func getAuditLogResponse(entry *entities.AuditLog) *response.AuditLogResponse {
+	return &response.AuditLogResponse{
+		ID:              entry.ID,
+		CreatedAt:       entry.CreatedAt,
+		ActorUserID:     entry.ActorUserID,
+		EstablishmentID: entry.EstablishmentID,
+		Action:          entry.Action,
+		EntityType:      entry.EntityType,
+		EntityID:        entry.EntityID,
+		Before:          json.RawMessage(entry.Before),
+		After:           json.RawMessage(entry.After),
+		IP:              entry.IP,
+		RequestID:       entry.RequestID,
+		StatusCode:      entry.StatusCode,
+		PrevHash:        entry.PrevHash,
+		Hash:            entry.Hash,
+	}
+}
@@ -1,14 +1,25 @@
 package repository
 
 import (
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
 	"gorm.io/gorm"
 )
 
+// clientListSpec describes the list queries on clients.
+var clientListSpec = listSpec{
+	table:       "clients",
+	sorts:       map[string]string{"id": "id", "email": "email", "credit_limit": "credit_limit", "created_at": "created_at"},
+	defaultSort: "id",
+	date:        "created_at",
+	amount:      "credit_limit",
+}
+
 type ClientRepository interface {
 	CreateClient(client *entities.Client) error
 	GetClientByUserID(userID uint) (*entities.Client, error)
-	GetAllClients() ([]entities.Client, error)
+	GetAllClients(query request.ListQuery, tenant Tenant) (*response.PageResponse[entities.Client], error)
 	GetClientByID(clientID uint) (*entities.Client, error)
 	UpdateClient(client *entities.Client) error
 	DeleteClient(clientID uint) error
@@ -35,13 +46,8 @@ func (r *clientRepository) GetClientByUserID(userID uint) (*entities.Client, err
 	return &client, nil
 }
 
-func (r *clientRepository) GetAllClients() ([]entities.Client, error) {
-	var clients []entities.Client
-	err := r.db.Find(&clients).Error
-	if err != nil {
-		return nil, err
-	}
-	return clients, nil
+func (r *clientRepository) GetAllClients(query request.ListQuery, tenant Tenant) (*response.PageResponse[entities.Client], error) {
+	return paginate[entities.Client](r.db.Scopes(tenantScope(tenant, ClientResource)), query, clientListSpec)
 }
 
 func (r *clientRepository) GetClientByID(clientID uint) (*entities.Client, error) {
@@ -4,15 +4,40 @@ import (
 	"errors"
 	"fmt"
 	"gorm.io/gorm/clause"
+	"strings"
 	"time"
 
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/ledger"
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"ApiRestFinance/internal/scoring"
 	"gorm.io/gorm"
 )
 
+// creditAccountListSpec describes the list queries on credit accounts.
+var creditAccountListSpec = listSpec{
+	table:       "credit_accounts",
+	sorts:       map[string]string{"id": "id", "created_at": "created_at", "credit_limit": "credit_limit", "current_balance": "current_balance"},
+	defaultSort: "id",
+	date:        "created_at",
+	amount:      "current_balance",
+	creditType:  "credit_type",
+}
+
+// creditRequestListSpec describes the list queries on credit requests.
+var creditRequestListSpec = listSpec{
+	table:       "credit_requests",
+	sorts:       map[string]string{"id": "id", "created_at": "created_at", "requested_credit_limit": "requested_credit_limit"},
+	defaultSort: "created_at",
+	date:        "created_at",
+	amount:      "requested_credit_limit",
+	creditType:  "credit_type",
+}
+
 // CreditAccountRepository defines the interface for credit account repository operations.
 type CreditAccountRepository interface {
 	Create(creditAccount *entities.CreditAccount) error
@@ -20,19 +45,26 @@ type CreditAccountRepository interface {
 	Update(id uint, req request.UpdateCreditAccountRequest) (*response.CreditAccountResponse, error)
 	Delete(id uint) error
 	GetByEstablishmentID(establishmentID uint) ([]response.CreditAccountResponse, error)
-	GetByClientID(clientID uint) ([]response.CreditAccountResponse, error)
-	ApplyInterest(creditAccountID uint) error
+	ListByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.CreditAccountResponse], error)
+	GetByClientID(clientID uint, query request.ListQuery, tenant Tenant) (*response.PageResponse[response.CreditAccountResponse], error)
+	ApplyInterest(creditAccountID uint, asOf time.Time) error
 	ApplyLateFee(creditAccountID uint) error
 	GetOverdueAccounts(establishmentID uint) ([]response.CreditAccountResponse, error)
+	GetDaysOverdue(establishmentID uint, asOf time.Time) (map[uint]int, error)
+	SetBlockedByPolicy(creditAccountID uint, blocked bool, reason string) error
 	ExistsByClientAndEstablishment(clientID uint, establishmentID uint) (bool, error)
+	GetScoringFactors(clientID uint, asOf time.Time) (*scoring.Factors, error)
 	CreateCreditRequest(creditRequest *entities.CreditRequest) error
 	GetCreditRequestByID(id uint) (*entities.CreditRequest, error)
 	UpdateCreditRequest(creditRequest *entities.CreditRequest) error
-	ProcessPurchase(creditAccountID uint, amount float64, description string) error
-	ProcessPayment(creditAccountID uint, amount float64, description string) error
+	ProcessPurchase(creditAccountID uint, amount money.Money, description string) error
+	ProcessItemizedPurchase(creditAccountID uint, req request.CreatePurchaseRequest) (*response.TransactionResponse, error)
+	ProcessPayment(creditAccountID uint, amount money.Money, description string) error
 	ApproveCreditRequest(creditRequest *entities.CreditRequest) (*response.CreditAccountResponse, error)
-	GetPendingCreditRequests(establishmentID uint) ([]entities.CreditRequest, error)
+	GetPendingCreditRequests(establishmentID uint, query request.ListQuery) (*response.PageResponse[entities.CreditRequest], error)
 	AssignCreditAccountToClient(creditAccountID, clientID uint) error
+	RegenerateSchedule(creditAccountID uint, req request.RegenerateScheduleRequest, start time.Time) ([]response.InstallmentResponse, error)
+	Prepay(creditAccountID uint, req request.PrepaymentRequest, asOf time.Time) (*response.PrepaymentResponse, error)
 }
 
 type creditAccountRepository struct {
@@ -44,9 +76,20 @@ func NewCreditAccountRepository(db *gorm.DB) CreditAccountRepository {
 	return &creditAccountRepository{db: db}
 }
 
-// Create creates a new credit account.
+// Create creates a new credit account along with its amortization schedule when it is LONG_TERM.
 func (r *creditAccountRepository) Create(creditAccount *entities.CreditAccount) error {
-	return r.db.Create(creditAccount).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(creditAccount).Error; err != nil {
+			return err
+		}
+
+		if err := disburse(tx, creditAccount); err != nil {
+			return err
+		}
+
+		_, err := createSchedule(tx, creditAccount, schedulePrincipal(creditAccount, money.Zero), creditAccount.Term, 1, creditAccount.CreatedAt)
+		return err
+	})
 }
 
 // GetByID retrieves a credit account by ID.
@@ -63,15 +106,41 @@ func (r *creditAccountRepository) GetByID(id uint) (*response.CreditAccountRespo
 // Update updates an existing credit account.
 func (r *creditAccountRepository) Update(id uint, req request.UpdateCreditAccountRequest) (*response.CreditAccountResponse, error) {
 	var creditAccount entities.CreditAccount
-	err := r.db.First(&creditAccount, id).Error
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, id).Error; err != nil {
+			return err
+		}
+
+		// Limit changes are recorded in the history and may not drop below the balance
+		if req.CreditLimit > 0 {
+			if err := applyCreditLimit(tx, &creditAccount, req.CreditLimit, false, "Credit limit updated"); err != nil {
+				return err
+			}
+		}
+		updateCreditAccountFields(&creditAccount, req)
+		if req.IsBlocked != nil {
+			reason := "Unblocked manually"
+			if *req.IsBlocked {
+				reason = "Blocked manually"
+			}
+			if err := applyBlock(tx, &creditAccount, *req.IsBlocked, reason); err != nil {
+				return err
+			}
+		}
+
+		return tx.Save(&creditAccount).Error
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Update fields only if they are provided in the request
-	if req.CreditLimit > 0 {
-		creditAccount.CreditLimit = req.CreditLimit
-	}
+	return getCreditAccountResponse(&creditAccount), nil
+}
+
+// updateCreditAccountFields copies the fields provided in an update request, except the credit
+// limit and the blocked flag.
+func updateCreditAccountFields(creditAccount *entities.CreditAccount, req request.UpdateCreditAccountRequest) {
 	if req.MonthlyDueDate > 0 {
 		creditAccount.MonthlyDueDate = req.MonthlyDueDate
 	}
@@ -81,20 +150,81 @@ func (r *creditAccountRepository) Update(id uint, req request.UpdateCreditAccoun
 	if req.InterestType != "" {
 		creditAccount.InterestType = req.InterestType
 	}
+	if req.PenaltyInterestRate != nil {
+		creditAccount.PenaltyInterestRate = *req.PenaltyInterestRate
+	}
+	if req.DayCount != "" {
+		creditAccount.DayCount = req.DayCount
+	}
+	if req.Compounding != "" {
+		creditAccount.Compounding = req.Compounding
+	}
 	if req.CreditType != "" {
 		creditAccount.CreditType = req.CreditType
 	}
-	if req.GracePeriod >= 0 {
-		creditAccount.GracePeriod = req.GracePeriod
+	if req.Term > 0 {
+		creditAccount.Term = req.Term
+	}
+	if req.AmortizationMethod != "" {
+		creditAccount.AmortizationMethod = req.AmortizationMethod
 	}
-	creditAccount.IsBlocked = req.IsBlocked
+	if req.GracePeriod != nil {
+		creditAccount.GracePeriod = *req.GracePeriod
+	}
+	if req.GraceType != "" {
+		creditAccount.GraceType = req.GraceType
+	}
+}
 
-	err = r.db.Save(&creditAccount).Error
-	if err != nil {
-		return nil, err
+// SetBlockedByPolicy blocks a credit account for the delinquency policy, or lifts a block the
+// policy placed. Accounts already in the requested state and blocks placed by staff are left alone.
+func (r *creditAccountRepository) SetBlockedByPolicy(creditAccountID uint, blocked bool, reason string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return fmt.Errorf("error retrieving credit account: %w", err)
+		}
+		if creditAccount.IsBlocked == blocked || (!blocked && !creditAccount.BlockedByPolicy) {
+			return nil
+		}
+
+		if err := applyBlock(tx, &creditAccount, blocked, reason); err != nil {
+			return err
+		}
+		creditAccount.BlockedByPolicy = blocked
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account: %w", err)
+		}
+		return nil
+	})
+}
+
+// applyBlock blocks or unblocks a credit account and records the change with its reason in the
+// history. The block is no longer attributed to the delinquency policy; the caller saves the account.
+func applyBlock(tx *gorm.DB, creditAccount *entities.CreditAccount, blocked bool, reason string) error {
+	if creditAccount.IsBlocked == blocked {
+		return nil
 	}
 
-	return getCreditAccountResponse(&creditAccount), nil
+	transactionType := enums.AccountUnblocked
+	if blocked {
+		transactionType = enums.AccountBlocked
+	}
+	creditAccount.IsBlocked = blocked
+	creditAccount.BlockedByPolicy = false
+
+	historyEntry := entities.CreditAccountHistory{
+		CreditAccountID: creditAccount.ID,
+		TransactionDate: time.Now(),
+		TransactionType: transactionType,
+		Amount:          money.Zero,
+		Balance:         creditAccount.CurrentBalance,
+		Description:     reason,
+	}
+	if err := tx.Create(&historyEntry).Error; err != nil {
+		return fmt.Errorf("error creating credit account history: %w", err)
+	}
+	return nil
 }
 
 // Delete deletes a credit account.
@@ -124,93 +254,180 @@ func (r *creditAccountRepository) GetByEstablishmentID(establishmentID uint) ([]
 	return creditAccountResponses, nil
 }
 
-// GetByClientID retrieves all credit accounts for a client.
-func (r *creditAccountRepository) GetByClientID(clientID uint) ([]response.CreditAccountResponse, error) {
-	var creditAccounts []entities.CreditAccount
-	err := r.db.Where("client_id = ?", clientID).Preload("Establishment").Find(&creditAccounts).Error
+// ListByEstablishmentID retrieves a page of the credit accounts of an establishment.
+func (r *creditAccountRepository) ListByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.CreditAccountResponse], error) {
+	page, err := paginate[entities.CreditAccount](r.db.Where("establishment_id = ?", establishmentID).Preload("Client"), query, creditAccountListSpec)
 	if err != nil {
 		return nil, err
 	}
+	return mapPage(page, getCreditAccountResponse), nil
+}
 
-	var creditAccountResponses []response.CreditAccountResponse
-	for _, creditAccount := range creditAccounts {
-		creditAccountResponses = append(creditAccountResponses, *getCreditAccountResponse(&creditAccount))
+// GetByClientID retrieves a page of the credit accounts of a client visible to a tenant.
+func (r *creditAccountRepository) GetByClientID(clientID uint, query request.ListQuery, tenant Tenant) (*response.PageResponse[response.CreditAccountResponse], error) {
+	db := r.db.Scopes(tenantScope(tenant, CreditAccountResource)).Where("client_id = ?", clientID).Preload("Establishment")
+	page, err := paginate[entities.CreditAccount](db, query, creditAccountListSpec)
+	if err != nil {
+		return nil, err
 	}
-
-	return creditAccountResponses, nil
+	return mapPage(page, getCreditAccountResponse), nil
 }
 
-// ApplyInterest calculates and applies interest to a credit account.
-func (r *creditAccountRepository) ApplyInterest(creditAccountID uint) error {
+// ApplyInterest accrues interest on a credit account up to asOf and posts it as an INTEREST_ACCRUAL.
+// Interest earned under a total grace period is posted as an INTEREST_CAPITALIZED instead.
+func (r *creditAccountRepository) ApplyInterest(creditAccountID uint, asOf time.Time) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// 1. Retrieve the credit account
 		var creditAccount entities.CreditAccount
-		if err := tx.Preload("Establishment").First(&creditAccount, creditAccountID).Error; err != nil {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
 			return fmt.Errorf("error retrieving credit account: %w", err)
 		}
 
 		// 2. Check if interest needs to be applied
-		if creditAccount.CurrentBalance == 0 || time.Now().Before(creditAccount.LastInterestAccrualDate.AddDate(0, 1, 0)) {
-			return nil // No balance or interest already applied this month
-		}
-
-		// 3. Calculate interest based on the interest type (Nominal or Effective)
-		interest := calculateInterest(creditAccount)
-
-		// 4. Create a transaction for the interest
-		interestTransaction := entities.Transaction{
-			CreditAccountID: creditAccountID,
-			RecipientType:   enums.RolClient,
-			RecipientID:     creditAccount.ClientID,
-			TransactionType: enums.InterestAccrual,
-			Amount:          interest,
-			Description:     "Monthly Interest",
-			TransactionDate: time.Now(),
+		if !finance.AccrualDue(creditAccount, asOf) {
+			return nil // Interest already applied this month
 		}
-		if err := tx.Create(&interestTransaction).Error; err != nil {
-			return fmt.Errorf("error creating interest transaction: %w", err)
+		if err := accruePenaltyInterest(tx, &creditAccount, asOf); err != nil {
+			return err
 		}
 
-		// 5. Update the credit account balance and last interest accrual date
-		creditAccount.CurrentBalance += interest
-		creditAccount.LastInterestAccrualDate = time.Now()
-		if err := tx.Save(&creditAccount).Error; err != nil {
-			return fmt.Errorf("error updating credit account balance: %w", err)
+		// 3. Calculate interest for the period with the shared interest engine
+		periodStart := finance.DateOf(creditAccount.LastInterestAccrualDate)
+		periodEnd := finance.DateOf(asOf)
+		var interest, capitalized money.Money
+		if creditAccount.CreditType == enums.LongTerm {
+			// LONG_TERM interest comes from the installments falling due in the period, and under total
+			// grace from the grace due dates, whose interest the schedule added to the principal
+			var dueInstallments []entities.Installment
+			if err := tx.Where("credit_account_id = ? AND due_date > ? AND due_date <= ?", creditAccountID, periodStart, periodEnd).
+				Find(&dueInstallments).Error; err != nil {
+				return fmt.Errorf("error retrieving installments due: %w", err)
+			}
+			interest = finance.ScheduledInterest(dueInstallments)
+			principal := finance.BalancesOf(creditAccount)[enums.PrincipalComponent]
+			capitalized = finance.CapitalizedGraceInterest(creditAccount, principal, periodStart, periodEnd)
+		} else if finance.InGracePeriod(creditAccount, periodEnd) && finance.CapitalizesInterest(creditAccount) {
+			// Under total grace the interest of a SHORT_TERM account is capitalized instead of charged
+			capitalized = finance.AccruedInterest(creditAccount, periodEnd)
+		} else {
+			interest = finance.AccruedInterest(creditAccount, periodEnd)
 		}
+		description := fmt.Sprintf("Interest %s to %s (%s)", periodStart.Format("2006-01-02"), periodEnd.Format("2006-01-02"), finance.TermsFor(creditAccount))
 
-		// 6. Create a credit account history record
-		historyEntry := entities.CreditAccountHistory{
-			CreditAccountID: creditAccountID,
-			TransactionDate: time.Now(),
-			TransactionType: enums.InterestAccrual,
-			Amount:          interest,
-			Balance:         creditAccount.CurrentBalance,
-			Description:     "Monthly Interest Accrued",
+		// 4. Post the interest, moving the accrual date forward even when nothing is owed, so the
+		// period is not charged twice
+		creditAccount.LastInterestAccrualDate = periodEnd
+		if err := postInterest(tx, &creditAccount, enums.InterestCapitalized, capitalized, "Capitalized "+description, periodEnd); err != nil {
+			return err
 		}
-		if err := tx.Create(&historyEntry).Error; err != nil {
-			return fmt.Errorf("error creating credit account history record: %w", err)
+		if err := postInterest(tx, &creditAccount, enums.InterestAccrual, interest, description, periodEnd); err != nil {
+			return err
 		}
+		return tx.Save(&creditAccount).Error
+	})
+}
 
+// accruePenaltyInterest charges the penalty interest earned by the overdue installments of a
+// LONG_TERM account up to asOf and moves its penalty accrual date forward. The caller saves the account.
+func accruePenaltyInterest(tx *gorm.DB, creditAccount *entities.CreditAccount, asOf time.Time) error {
+	accruedTo := finance.DateOf(asOf)
+	if creditAccount.CreditType != enums.LongTerm || creditAccount.PenaltyInterestRate <= 0 ||
+		(creditAccount.LastPenaltyAccrualDate != nil && !accruedTo.After(*creditAccount.LastPenaltyAccrualDate)) {
 		return nil
-	})
+	}
+
+	var overdueInstallments []entities.Installment
+	if err := tx.Where("credit_account_id = ? AND due_date < ? AND status <> ?", creditAccount.ID, accruedTo, enums.Paid).
+		Find(&overdueInstallments).Error; err != nil {
+		return fmt.Errorf("error retrieving overdue installments: %w", err)
+	}
+	penalty := finance.PenaltyInterest(*creditAccount, overdueInstallments, asOf)
+	creditAccount.LastPenaltyAccrualDate = &accruedTo
+
+	description := fmt.Sprintf("Penalty interest to %s (%s)", accruedTo.Format("2006-01-02"), finance.PenaltyTermsFor(*creditAccount))
+	return postInterest(tx, creditAccount, enums.PenaltyInterest, penalty, description, asOf)
 }
 
-// ApplyLateFee applies a late fee to a credit account based on the configured rules.
+// postInterest creates an interest transaction, posts its journal entry and records it in the
+// history of the credit account. Nothing is posted for a zero amount; the caller saves the account.
+func postInterest(tx *gorm.DB, creditAccount *entities.CreditAccount, transactionType enums.TransactionType, interest money.Money, description string, date time.Time) error {
+	if !interest.IsPositive() {
+		return nil
+	}
+
+	interestTransaction := entities.Transaction{
+		CreditAccountID: creditAccount.ID,
+		RecipientType:   enums.RolClient,
+		RecipientID:     creditAccount.ClientID,
+		TransactionType: transactionType,
+		Amount:          interest,
+		Description:     description,
+		TransactionDate: date,
+	}
+	if err := tx.Create(&interestTransaction).Error; err != nil {
+		return fmt.Errorf("error creating interest transaction: %w", err)
+	}
+
+	lines, _ := ledger.Lines(transactionType, interest, nil)
+	if _, err := postLedger(tx, creditAccount, &interestTransaction, lines); err != nil {
+		return err
+	}
+
+	historyEntry := entities.CreditAccountHistory{
+		CreditAccountID: creditAccount.ID,
+		TransactionID:   &interestTransaction.ID,
+		TransactionDate: date,
+		TransactionType: transactionType,
+		Amount:          interest,
+		Balance:         creditAccount.CurrentBalance,
+		Description:     description,
+	}
+	if err := tx.Create(&historyEntry).Error; err != nil {
+		return fmt.Errorf("error creating credit account history record: %w", err)
+	}
+
+	return nil
+}
+
+// ErrNoLateFeeRule is returned when no late fee rule applies to an overdue credit account.
+var ErrNoLateFeeRule = errors.New("no late fee rule found for this credit account")
+
+// ApplyLateFee applies a late fee to a credit account based on the configured rules. At most one
+// fee is charged per due date, however often the batch runs.
 func (r *creditAccountRepository) ApplyLateFee(creditAccountID uint) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		// 1. Retrieve the credit account with LateFeeRule and Establishment
+		// 1. Retrieve and lock the credit account with LateFeeRule and Establishment
 		var creditAccount entities.CreditAccount
-		if err := tx.Preload("LateFeeRule").Preload("Establishment.LateFeeRules").First(&creditAccount, creditAccountID).Error; err != nil {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("LateFeeRule").Preload("Establishment.LateFeeRules").First(&creditAccount, creditAccountID).Error; err != nil {
 			return fmt.Errorf("error retrieving credit account: %w", err)
 		}
 
-		// 2. Calculate the number of days overdue
-		daysOverdue := calculateDaysOverdue(creditAccount.MonthlyDueDate)
+		// 2. No late fees are charged inside the grace period
+		now := time.Now()
+		if finance.InGracePeriod(creditAccount, now) {
+			return nil
+		}
+
+		// 3. Calculate the number of days overdue
+		daysOverdue := daysSinceDueDate(creditAccount.MonthlyDueDate, now)
 		if daysOverdue <= 0 {
 			return nil // Account is not overdue
 		}
 
-		// 3. Find the applicable late fee rule
+		// 4. Skip the account when the fee for the current due date was already charged
+		dueDate := finance.DueDate(now, creditAccount.MonthlyDueDate, 0)
+		var charged int64
+		if err := tx.Model(&entities.Transaction{}).
+			Where("credit_account_id = ? AND transaction_type = ? AND transaction_date >= ?", creditAccountID, enums.LateFeeApplied, dueDate).
+			Count(&charged).Error; err != nil {
+			return fmt.Errorf("error checking late fees charged: %w", err)
+		}
+		if charged > 0 {
+			return nil
+		}
+
+		// 5. Find the applicable late fee rule
 		var lateFeeRule *entities.LateFeeRule
 		if creditAccount.LateFeeRule != nil {
 			lateFeeRule = creditAccount.LateFeeRule
@@ -224,16 +441,18 @@ func (r *creditAccountRepository) ApplyLateFee(creditAccountID uint) error {
 			}
 		}
 
-		// 4. Handle case where no applicable LateFeeRule is found
+		// 6. Handle case where no applicable LateFeeRule is found
 		if lateFeeRule == nil {
-			// You might have a default rule or skip applying a late fee
-			return errors.New("no late fee rule found for this credit account")
+			return ErrNoLateFeeRule
 		}
 
-		// 5. Calculate the late fee amount
+		// 7. Calculate the late fee amount, skipping rules that charge nothing
 		lateFeeAmount := calculateLateFee(creditAccount, *lateFeeRule, daysOverdue)
+		if !lateFeeAmount.IsPositive() {
+			return nil
+		}
 
-		// 6. Create a late fee record
+		// 8. Create a late fee record
 		lateFee := entities.LateFee{
 			CreditAccountID: creditAccountID,
 			Amount:          lateFeeAmount,
@@ -243,16 +462,34 @@ func (r *creditAccountRepository) ApplyLateFee(creditAccountID uint) error {
 			return fmt.Errorf("error creating late fee record: %w", err)
 		}
 
-		// 7. Update the credit account balance
-		creditAccount.CurrentBalance += lateFeeAmount
+		// 9. Create the late fee transaction and post its journal entry
+		feeTransaction := entities.Transaction{
+			CreditAccountID: creditAccountID,
+			RecipientType:   enums.RolClient,
+			RecipientID:     creditAccount.ClientID,
+			TransactionType: enums.LateFeeApplied,
+			Amount:          lateFeeAmount,
+			Description:     "Late Payment Fee Applied",
+			TransactionDate: lateFee.AppliedDate,
+		}
+		if err := tx.Create(&feeTransaction).Error; err != nil {
+			return fmt.Errorf("error creating late fee transaction: %w", err)
+		}
+		lines, _ := ledger.Lines(enums.LateFeeApplied, lateFeeAmount, nil)
+		if _, err := postLedger(tx, &creditAccount, &feeTransaction, lines); err != nil {
+			return err
+		}
+
+		// 10. Update the credit account balance
 		if err := tx.Save(&creditAccount).Error; err != nil {
 			return fmt.Errorf("error updating credit account balance: %w", err)
 		}
 
-		// 8. Create a credit account history record
+		// 11. Create a credit account history record
 		historyEntry := entities.CreditAccountHistory{
 			CreditAccountID: creditAccountID,
-			TransactionDate: time.Now(),
+			TransactionID:   &feeTransaction.ID,
+			TransactionDate: lateFee.AppliedDate,
 			TransactionType: enums.LateFeeApplied,
 			Amount:          lateFeeAmount,
 			Balance:         creditAccount.CurrentBalance,
@@ -285,55 +522,96 @@ func (r *creditAccountRepository) GetOverdueAccounts(establishmentID uint) ([]re
 	return overdueAccountResponses, nil
 }
 
-// Helper functions for calculations
+// GetDaysOverdue returns the days the oldest arrears of each credit account of an establishment
+// have been overdue. LONG_TERM accounts are overdue from their oldest unpaid installment and
+// SHORT_TERM accounts from their monthly due date; accounts without arrears are left out.
+func (r *creditAccountRepository) GetDaysOverdue(establishmentID uint, asOf time.Time) (map[uint]int, error) {
+	daysOverdue := make(map[uint]int)
+
+	var installments []struct {
+		CreditAccountID uint
+		DueDate         time.Time
+	}
+	accountIDs := r.db.Model(&entities.CreditAccount{}).Select("id").Where("establishment_id = ? AND credit_type = ?", establishmentID, enums.LongTerm)
+	if err := r.db.Model(&entities.Installment{}).
+		Select("credit_account_id, MIN(due_date) AS due_date").
+		Where("credit_account_id IN (?) AND due_date < ? AND status <> ?", accountIDs, asOf, enums.Paid).
+		Group("credit_account_id").
+		Scan(&installments).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving overdue installments: %w", err)
+	}
+	for _, installment := range installments {
+		if days := int(asOf.Sub(installment.DueDate).Hours() / 24); days > 0 {
+			daysOverdue[installment.CreditAccountID] = days
+		}
+	}
 
-func calculateInterest(creditAccount entities.CreditAccount) float64 {
-	// Implement your interest calculation logic based on InterestType
-	// (Nominal or Effective) and CreditType (ShortTerm or LongTerm)
-	// ...
+	var accounts []entities.CreditAccount
+	if err := r.db.Select("id", "monthly_due_date").
+		Where("establishment_id = ? AND credit_type = ? AND current_balance > 0", establishmentID, enums.ShortTerm).
+		Find(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving credit accounts: %w", err)
+	}
+	for _, account := range accounts {
+		if days := daysSinceDueDate(account.MonthlyDueDate, asOf); days > 0 {
+			daysOverdue[account.ID] = days
+		}
+	}
 
-	return 0.0 // Placeholder, replace with actual calculation
+	return daysOverdue, nil
 }
 
-func calculateDaysOverdue(monthlyDueDate int) int {
-	today := time.Now()
-	dueDate := time.Date(today.Year(), today.Month(), monthlyDueDate, 0, 0, 0, 0, time.UTC)
+// Helper functions for calculations
 
-	if today.Before(dueDate) {
+// daysSinceDueDate returns the days elapsed since the due date of the month of asOf. Due days
+// past the end of a shorter month fall on its last day.
+func daysSinceDueDate(monthlyDueDate int, asOf time.Time) int {
+	dueDate := finance.DueDate(asOf, monthlyDueDate, 0)
+
+	if asOf.Before(dueDate) {
 		return 0 // Not overdue
 	}
 
-	return int(today.Sub(dueDate).Hours() / 24)
+	return int(asOf.Sub(dueDate).Hours() / 24)
 }
 
-func calculateLateFee(creditAccount entities.CreditAccount, rule entities.LateFeeRule, daysOverdue int) float64 {
+func calculateLateFee(creditAccount entities.CreditAccount, rule entities.LateFeeRule, daysOverdue int) money.Money {
 	if daysOverdue < rule.DaysOverdueMin || daysOverdue > rule.DaysOverdueMax {
-		return 0 // Rule does not apply
+		return money.Zero // Rule does not apply
 	}
 
 	if rule.FeeType == enums.Percentage {
-		return rule.FeeValue / 100 * creditAccount.CurrentBalance // Percentage of balance
+		return creditAccount.CurrentBalance.Percent(rule.FeeValue) // Percentage of balance, rounded to the cent
 	} else { // enums.FixedAmount
-		return rule.FeeValue // Fixed amount
+		return money.FromFloat(rule.FeeValue) // Fixed amount
 	}
 }
 
 func getCreditAccountResponse(creditAccount *entities.CreditAccount) *response.CreditAccountResponse {
 	return &response.CreditAccountResponse{
-		ID:              creditAccount.ID,
-		EstablishmentID: creditAccount.EstablishmentID,
-		ClientID:        creditAccount.ClientID,
-		CreditLimit:     creditAccount.CreditLimit,
-		CurrentBalance:  creditAccount.CurrentBalance,
-		MonthlyDueDate:  creditAccount.MonthlyDueDate,
-		InterestRate:    creditAccount.InterestRate,
-		InterestType:    creditAccount.InterestType,
-		CreditType:      creditAccount.CreditType,
-		GracePeriod:     creditAccount.GracePeriod,
-		IsBlocked:       creditAccount.IsBlocked,
-		CreatedAt:       creditAccount.CreatedAt,
-		UpdatedAt:       creditAccount.UpdatedAt,
-		Client:          getClientResponse(&creditAccount.Client),
+		ID:                     creditAccount.ID,
+		EstablishmentID:        creditAccount.EstablishmentID,
+		ClientID:               creditAccount.ClientID,
+		CreditLimit:            creditAccount.CreditLimit,
+		CurrentBalance:         creditAccount.CurrentBalance,
+		FeesBalance:            creditAccount.FeesBalance,
+		PenaltyInterestBalance: creditAccount.PenaltyInterestBalance,
+		InterestBalance:        creditAccount.InterestBalance,
+		MonthlyDueDate:         creditAccount.MonthlyDueDate,
+		InterestRate:           creditAccount.InterestRate,
+		InterestType:           creditAccount.InterestType,
+		DayCount:               creditAccount.DayCount,
+		Compounding:            creditAccount.Compounding,
+		CreditType:             creditAccount.CreditType,
+		Term:                   creditAccount.Term,
+		AmortizationMethod:     creditAccount.AmortizationMethod,
+		GracePeriod:            creditAccount.GracePeriod,
+		GraceType:              creditAccount.GraceType,
+		IsBlocked:              creditAccount.IsBlocked,
+		BlockedByPolicy:        creditAccount.BlockedByPolicy,
+		CreatedAt:              creditAccount.CreatedAt,
+		UpdatedAt:              creditAccount.UpdatedAt,
+		Client:                 getClientResponse(&creditAccount.Client),
 	}
 }
 
@@ -365,8 +643,66 @@ func (r *creditAccountRepository) ExistsByClientAndEstablishment(clientID uint,
 	return count > 0, nil
 }
 
+// GetScoringFactors gathers the credit history of a client across every establishment.
+func (r *creditAccountRepository) GetScoringFactors(clientID uint, asOf time.Time) (*scoring.Factors, error) {
+	var accounts struct {
+		Balance     money.Money
+		CreditLimit money.Money
+		Oldest      *time.Time
+	}
+	if err := r.db.Model(&entities.CreditAccount{}).
+		Select("COALESCE(SUM(current_balance), 0) AS balance, COALESCE(SUM(credit_limit), 0) AS credit_limit, MIN(created_at) AS oldest").
+		Where("client_id = ?", clientID).
+		Scan(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("error summing credit accounts: %w", err)
+	}
+
+	accountIDs := r.db.Model(&entities.CreditAccount{}).Select("id").Where("client_id = ?", clientID)
+	var payments, lateFees, overdue int64
+	if err := r.db.Model(&entities.CreditAccountHistory{}).
+		Where("credit_account_id IN (?) AND transaction_type IN ?", accountIDs, []enums.TransactionType{enums.Payment, enums.EarlyPayment}).
+		Count(&payments).Error; err != nil {
+		return nil, fmt.Errorf("error counting payments: %w", err)
+	}
+	if err := r.db.Model(&entities.CreditAccountHistory{}).
+		Where("credit_account_id IN (?) AND transaction_type = ?", accountIDs, enums.LateFeeApplied).
+		Count(&lateFees).Error; err != nil {
+		return nil, fmt.Errorf("error counting late fees: %w", err)
+	}
+	if err := r.db.Model(&entities.Installment{}).
+		Where("credit_account_id IN (?) AND due_date < ? AND status <> ?", accountIDs, asOf, enums.Paid).
+		Count(&overdue).Error; err != nil {
+		return nil, fmt.Errorf("error counting overdue installments: %w", err)
+	}
+
+	return &scoring.Factors{
+		Payments:            int(payments),
+		LateFees:            int(lateFees),
+		OverdueInstallments: int(overdue),
+		Balance:             accounts.Balance,
+		CreditLimit:         accounts.CreditLimit,
+		OldestAccount:       accounts.Oldest,
+	}, nil
+}
+
+// CreateCreditRequest stores a credit request and, in the same transaction, opens the credit
+// account of an automatically approved one, so the request is never left approved without it.
 func (r *creditAccountRepository) CreateCreditRequest(creditRequest *entities.CreditRequest) error {
-	return r.db.Create(creditRequest).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(creditRequest).Error; err != nil {
+			return err
+		}
+		if creditRequest.Decision != enums.AutoApproved {
+			return nil
+		}
+
+		var establishment entities.Establishment
+		if err := tx.Select("id", "late_fee_rule_id").First(&establishment, creditRequest.EstablishmentID).Error; err != nil {
+			return fmt.Errorf("error retrieving establishment: %w", err)
+		}
+		_, err := openCreditAccount(tx, creditRequest, establishment.LateFeeRuleID)
+		return err
+	})
 }
 
 // UpdateCreditRequest updates a credit request in the database.
@@ -374,7 +710,18 @@ func (r *creditAccountRepository) UpdateCreditRequest(creditRequest *entities.Cr
 	return r.db.Save(creditRequest).Error
 }
 
-func (r *creditAccountRepository) ProcessPurchase(creditAccountID uint, amount float64, description string) error {
+var (
+	// ErrAccountBlocked is returned when a purchase is charged to a blocked credit account.
+	ErrAccountBlocked = errors.New("credit account is blocked, cannot process purchase")
+	// ErrCreditLimitExceeded is returned when a purchase would take the balance over the credit limit.
+	ErrCreditLimitExceeded = errors.New("purchase exceeds credit limit")
+	// ErrInvalidPurchase is returned when an item of a purchase cannot be sold to the account.
+	ErrInvalidPurchase = errors.New("invalid purchase")
+	// ErrInsufficientStock is returned when a purchase asks for more units than a product has.
+	ErrInsufficientStock = errors.New("insufficient stock")
+)
+
+func (r *creditAccountRepository) ProcessPurchase(creditAccountID uint, amount money.Money, description string) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// 1. Retrieve the credit account
 		var creditAccount entities.CreditAccount
@@ -382,54 +729,173 @@ func (r *creditAccountRepository) ProcessPurchase(creditAccountID uint, amount f
 			return fmt.Errorf("error retrieving credit account for purchase: %w", err)
 		}
 
-		// 2. Check if the account is blocked
-		if creditAccount.IsBlocked {
-			return errors.New("credit account is blocked, cannot process purchase")
+		// 2. Charge the purchase
+		_, err := postPurchase(tx, &creditAccount, amount, description)
+		return err
+	})
+}
+
+// ProcessItemizedPurchase charges products of the establishment of a credit account. The
+// products are locked while their stock and status are checked, and their stock is decremented
+// in the same database transaction as the balance, so concurrent sales cannot oversell.
+func (r *creditAccountRepository) ProcessItemizedPurchase(creditAccountID uint, req request.CreatePurchaseRequest) (*response.TransactionResponse, error) {
+	var transaction *entities.Transaction
+	var purchaseLines []entities.PurchaseLine
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve the credit account
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return fmt.Errorf("error retrieving credit account for purchase: %w", err)
 		}
 
-		// 3. Check if the purchase exceeds the credit limit
-		if creditAccount.CurrentBalance+amount > creditAccount.CreditLimit {
-			return errors.New("purchase exceeds credit limit")
+		// 2. Lock the products, in ID order so concurrent purchases do not deadlock
+		quantities := make(map[uint]int)
+		productIDs := make([]uint, 0, len(req.Items))
+		for _, item := range req.Items {
+			if _, ok := quantities[item.ProductID]; !ok {
+				productIDs = append(productIDs, item.ProductID)
+			}
+			quantities[item.ProductID] += item.Quantity
+		}
+		var products []entities.Product
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", productIDs).Order("id").Find(&products).Error; err != nil {
+			return fmt.Errorf("error retrieving products: %w", err)
+		}
+		productsByID := make(map[uint]*entities.Product, len(products))
+		for i := range products {
+			productsByID[products[i].ID] = &products[i]
 		}
 
-		// 4. Create the purchase transaction
-		transaction := entities.Transaction{
-			CreditAccountID: creditAccountID,
-			RecipientType:   enums.RolClient,
-			RecipientID:     creditAccount.ClientID,
-			TransactionType: enums.Purchase,
-			Amount:          amount,
-			Description:     description,
-			TransactionDate: time.Now(),
+		// 3. Check the products and price the lines
+		var total money.Money
+		var names []string
+		for _, item := range req.Items {
+			product, ok := productsByID[item.ProductID]
+			if !ok || product.EstablishmentID != creditAccount.EstablishmentID {
+				return fmt.Errorf("%w: product %d not found", ErrInvalidPurchase, item.ProductID)
+			}
+			if !product.IsActive {
+				return fmt.Errorf("%w: product %d is not active", ErrInvalidPurchase, item.ProductID)
+			}
+			if quantities[product.ID] > product.Stock {
+				return fmt.Errorf("%w: product %d has %d units left", ErrInsufficientStock, product.ID, product.Stock)
+			}
+
+			line := entities.PurchaseLine{
+				CreditAccountID: creditAccountID,
+				ProductID:       product.ID,
+				ProductName:     product.Name,
+				Quantity:        item.Quantity,
+				UnitPrice:       product.Price,
+			}
+			if item.UnitPrice != nil && *item.UnitPrice != product.Price {
+				line.UnitPrice = *item.UnitPrice
+				line.PriceOverridden = true
+			}
+			line.Amount = line.UnitPrice.Mul(int64(item.Quantity))
+			total += line.Amount
+			purchaseLines = append(purchaseLines, line)
+			names = append(names, fmt.Sprintf("%d x %s", item.Quantity, product.Name))
 		}
-		if err := tx.Create(&transaction).Error; err != nil {
-			return fmt.Errorf("error creating purchase transaction: %w", err)
+		if !total.IsPositive() {
+			return fmt.Errorf("%w: total must be positive", ErrInvalidPurchase)
 		}
 
-		// 5. Update the credit account balance
-		creditAccount.CurrentBalance += amount
-		if err := tx.Save(&creditAccount).Error; err != nil {
-			return fmt.Errorf("error updating credit account balance: %w", err)
+		// 4. Charge the purchase
+		description := req.Description
+		if description == "" {
+			description = strings.Join(names, ", ")
+		}
+		var err error
+		if transaction, err = postPurchase(tx, &creditAccount, total, description); err != nil {
+			return err
 		}
 
-		// 6. Create a CreditAccountHistory record
-		historyEntry := entities.CreditAccountHistory{
-			CreditAccountID: creditAccountID,
-			TransactionDate: time.Now(),
-			TransactionType: enums.Purchase,
-			Amount:          amount,
-			Balance:         creditAccount.CurrentBalance,
-			Description:     description,
+		// 5. Record the lines and take the units out of stock
+		for i := range purchaseLines {
+			purchaseLines[i].TransactionID = transaction.ID
 		}
-		if err := tx.Create(&historyEntry).Error; err != nil {
-			return fmt.Errorf("error creating credit account history: %w", err)
+		if err := tx.Create(&purchaseLines).Error; err != nil {
+			return fmt.Errorf("error creating purchase lines: %w", err)
+		}
+		for i := range products {
+			product := &products[i]
+			if err := tx.Model(product).Update("stock", product.Stock-quantities[product.ID]).Error; err != nil {
+				return fmt.Errorf("error updating product stock: %w", err)
+			}
 		}
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	transactionResponse := getTransactionResponse(transaction)
+	for i := range purchaseLines {
+		transactionResponse.Lines = append(transactionResponse.Lines, *getPurchaseLineResponse(&purchaseLines[i]))
+	}
+	return transactionResponse, nil
 }
 
-func (r *creditAccountRepository) ProcessPayment(creditAccountID uint, amount float64, description string) error {
+// postPurchase charges a purchase to a locked credit account: it posts the PURCHASE transaction
+// and its journal entry, saves the new balance and records the history entry.
+func postPurchase(tx *gorm.DB, creditAccount *entities.CreditAccount, amount money.Money, description string) (*entities.Transaction, error) {
+	// 1. Check if the account is blocked
+	if creditAccount.IsBlocked {
+		return nil, ErrAccountBlocked
+	}
+
+	// 2. Check if the purchase exceeds the credit limit
+	if creditAccount.CurrentBalance+amount > creditAccount.CreditLimit {
+		return nil, ErrCreditLimitExceeded
+	}
+
+	// 3. Create the purchase transaction
+	transaction := entities.Transaction{
+		CreditAccountID: creditAccount.ID,
+		RecipientType:   enums.RolClient,
+		RecipientID:     creditAccount.ClientID,
+		TransactionType: enums.Purchase,
+		Amount:          amount,
+		Description:     description,
+		TransactionDate: time.Now(),
+	}
+	if err := tx.Create(&transaction).Error; err != nil {
+		return nil, fmt.Errorf("error creating purchase transaction: %w", err)
+	}
+
+	// 4. Post the journal entry and update the credit account balance
+	lines, _ := ledger.Lines(enums.Purchase, amount, nil)
+	if _, err := postLedger(tx, creditAccount, &transaction, lines); err != nil {
+		return nil, err
+	}
+	if err := tx.Save(creditAccount).Error; err != nil {
+		return nil, fmt.Errorf("error updating credit account balance: %w", err)
+	}
+
+	// 5. Create a CreditAccountHistory record
+	historyEntry := entities.CreditAccountHistory{
+		CreditAccountID: creditAccount.ID,
+		TransactionID:   &transaction.ID,
+		TransactionDate: time.Now(),
+		TransactionType: enums.Purchase,
+		Amount:          amount,
+		Balance:         creditAccount.CurrentBalance,
+		Description:     description,
+	}
+	if err := tx.Create(&historyEntry).Error; err != nil {
+		return nil, fmt.Errorf("error creating credit account history: %w", err)
+	}
+
+	return &transaction, nil
+}
+
+// ProcessPayment posts a payment and allocates it across fees, penalty interest, interest and
+// principal following the establishment's waterfall. For LONG_TERM accounts the interest and
+// principal parts settle installments oldest first. Every part is recorded as a PaymentAllocation.
+func (r *creditAccountRepository) ProcessPayment(creditAccountID uint, amount money.Money, description string) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// 1. Retrieve the credit account
 		var creditAccount entities.CreditAccount
@@ -437,12 +903,18 @@ func (r *creditAccountRepository) ProcessPayment(creditAccountID uint, amount fl
 			return fmt.Errorf("error retrieving credit account for payment: %w", err)
 		}
 
-		// 2. Check if payment exceeds current balance
+		// 2. Bring the penalty interest of overdue installments up to date, so the payment settles it
+		now := time.Now()
+		if err := accruePenaltyInterest(tx, &creditAccount, now); err != nil {
+			return err
+		}
+
+		// 3. Check if payment exceeds current balance
 		if amount > creditAccount.CurrentBalance {
 			return errors.New("payment amount exceeds current balance")
 		}
 
-		// 3. Create the payment transaction
+		// 4. Create the payment transaction
 		transaction := entities.Transaction{
 			CreditAccountID: creditAccountID,
 			RecipientType:   enums.RolEstablishment, // Payment is to the establishment
@@ -450,22 +922,27 @@ func (r *creditAccountRepository) ProcessPayment(creditAccountID uint, amount fl
 			TransactionType: enums.Payment,
 			Amount:          amount,
 			Description:     description,
-			TransactionDate: time.Now(),
+			TransactionDate: now,
 		}
 		if err := tx.Create(&transaction).Error; err != nil {
 			return fmt.Errorf("error creating payment transaction: %w", err)
 		}
 
-		// 4. Update the credit account balance
-		creditAccount.CurrentBalance -= amount
+		// 5. Allocate the payment and post its journal entry
+		if err := applyPayment(tx, &creditAccount, &transaction); err != nil {
+			return err
+		}
+
+		// 6. Update the credit account; blocks are lifted by staff or the delinquency policy
 		if err := tx.Save(&creditAccount).Error; err != nil {
 			return fmt.Errorf("error updating credit account balance: %w", err)
 		}
 
-		// 5. Create a CreditAccountHistory record
+		// 7. Create a CreditAccountHistory record
 		historyEntry := entities.CreditAccountHistory{
 			CreditAccountID: creditAccountID,
-			TransactionDate: time.Now(),
+			TransactionID:   &transaction.ID,
+			TransactionDate: now,
 			TransactionType: enums.Payment,
 			Amount:          -amount, // Payment reduces balance
 			Balance:         creditAccount.CurrentBalance,
@@ -475,51 +952,124 @@ func (r *creditAccountRepository) ProcessPayment(creditAccountID uint, amount fl
 			return fmt.Errorf("error creating credit account history: %w", err)
 		}
 
-		// 6. Unblock the account if it was blocked and balance is 0 or less
-		if creditAccount.IsBlocked && creditAccount.CurrentBalance <= 0 {
-			creditAccount.IsBlocked = false
-			if err := tx.Save(&creditAccount).Error; err != nil {
-				return fmt.Errorf("error unblocking credit account: %w", err)
+		return nil
+	})
+}
+
+// applyPayment splits a payment following the establishment's allocation order, settles the
+// installments, records the PaymentAllocation rows and posts the journal entry, which updates
+// the balances of the credit account.
+func applyPayment(tx *gorm.DB, creditAccount *entities.CreditAccount, transaction *entities.Transaction) error {
+	// 1. Split the payment following the establishment's allocation order
+	var establishment entities.Establishment
+	if err := tx.Select("id", "payment_allocation_order").First(&establishment, creditAccount.EstablishmentID).Error; err != nil {
+		return fmt.Errorf("error retrieving establishment: %w", err)
+	}
+	order, err := finance.ParseAllocationOrder(establishment.PaymentAllocationOrder)
+	if err != nil {
+		return fmt.Errorf("error reading payment allocation order: %w", err)
+	}
+	allocation, unapplied := finance.Allocate(transaction.Amount, finance.BalancesOf(*creditAccount), order)
+	allocation[enums.PrincipalComponent] += unapplied // Keeps the components in line with the balance
+
+	// 2. Post the journal entry, which moves the balance and its components
+	lines, _ := ledger.Lines(transaction.TransactionType, transaction.Amount, allocation)
+	if _, err := postLedger(tx, creditAccount, transaction, lines); err != nil {
+		return err
+	}
+
+	// 3. Settle the installments and record how the payment was split
+	allocations, err := allocateToInstallments(tx, creditAccount, transaction.ID, allocation, transaction.TransactionDate)
+	if err != nil {
+		return err
+	}
+	for _, component := range order {
+		amount := allocation[component]
+		if component == enums.InterestComponent || component == enums.PrincipalComponent {
+			// What was not applied to an installment is still recorded against the component
+			amount -= sumAllocated(allocations, component)
+		}
+		if amount.IsPositive() {
+			allocations = append(allocations, entities.PaymentAllocation{
+				TransactionID:   transaction.ID,
+				CreditAccountID: creditAccount.ID,
+				Component:       component,
+				Amount:          amount,
+			})
+		}
+	}
+	if len(allocations) > 0 {
+		if err := tx.Create(&allocations).Error; err != nil {
+			return fmt.Errorf("error creating payment allocations: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// allocateToInstallments applies the interest and principal parts of a payment to the open
+// installments of a LONG_TERM account, oldest first, and returns one allocation per part applied.
+func allocateToInstallments(tx *gorm.DB, creditAccount *entities.CreditAccount, transactionID uint, allocation finance.Balances, paidAt time.Time) ([]entities.PaymentAllocation, error) {
+	if creditAccount.CreditType != enums.LongTerm {
+		return nil, nil
+	}
+
+	var installments []entities.Installment
+	if err := tx.Where("credit_account_id = ? AND status IN ?", creditAccount.ID, []enums.InstallmentStatus{enums.Pending, enums.Overdue, enums.PartiallyPaid}).
+		Order("due_date, number").
+		Find(&installments).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving open installments: %w", err)
+	}
+
+	var allocations []entities.PaymentAllocation
+	payments := finance.ApplyToInstallments(installments, allocation[enums.InterestComponent], allocation[enums.PrincipalComponent], paidAt)
+	for _, payment := range payments {
+		installment := &installments[payment.Index]
+		if err := tx.Save(installment).Error; err != nil {
+			return nil, fmt.Errorf("error updating installment: %w", err)
+		}
+		parts := []struct {
+			component enums.AllocationComponent
+			amount    money.Money
+		}{
+			{enums.InterestComponent, payment.Interest},
+			{enums.PrincipalComponent, payment.Principal},
+		}
+		for _, part := range parts {
+			if part.amount.IsPositive() {
+				allocations = append(allocations, entities.PaymentAllocation{
+					TransactionID:   transactionID,
+					CreditAccountID: creditAccount.ID,
+					InstallmentID:   &installment.ID,
+					Component:       part.component,
+					Amount:          part.amount,
+				})
 			}
 		}
+	}
 
-		return nil
-	})
+	return allocations, nil
+}
+
+func sumAllocated(allocations []entities.PaymentAllocation, component enums.AllocationComponent) money.Money {
+	var total money.Money
+	for _, allocation := range allocations {
+		if allocation.Component == component {
+			total += allocation.Amount
+		}
+	}
+	return total
 }
 
 func (r *creditAccountRepository) ApproveCreditRequest(creditRequest *entities.CreditRequest) (*response.CreditAccountResponse, error) {
 	var creditAccountResponse *response.CreditAccountResponse // Variable to store the response
 
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		// 1. Create the CreditAccount entity from the request
-		creditAccount := entities.CreditAccount{ // Create CreditAccount entity
-			EstablishmentID:         creditRequest.EstablishmentID,
-			ClientID:                creditRequest.ClientID,
-			CreditLimit:             creditRequest.RequestedCreditLimit,
-			MonthlyDueDate:          creditRequest.MonthlyDueDate,
-			InterestRate:            creditRequest.InterestRate,
-			InterestType:            creditRequest.InterestType,
-			CreditType:              creditRequest.CreditType,
-			GracePeriod:             creditRequest.GracePeriod,
-			IsBlocked:               false,
-			LastInterestAccrualDate: time.Now(),
-			CurrentBalance:          0, // Initial balance is 0
-			LateFeeRuleID:           creditRequest.Establishment.LateFeeRuleID,
-		}
-
-		// 2. Save the credit account
-		if err := tx.Create(&creditAccount).Error; err != nil {
-			return fmt.Errorf("error creating credit account: %w", err)
-		}
-
-		// 3. Update the credit request status
-		now := time.Now()
-		creditRequest.Status = entities.Approved
-		creditRequest.ApprovedAt = &now
-		if err := tx.Save(creditRequest).Error; err != nil { // No need to call a separate method
-			return fmt.Errorf("error updating credit request status: %w", err)
+		creditAccount, err := openCreditAccount(tx, creditRequest, creditRequest.Establishment.LateFeeRuleID)
+		if err != nil {
+			return err
 		}
-
+		creditAccountResponse = getCreditAccountResponse(creditAccount)
 		return nil // Successful transaction
 	})
 
@@ -530,6 +1080,54 @@ func (r *creditAccountRepository) ApproveCreditRequest(creditRequest *entities.C
 	return creditAccountResponse, nil // Return the response object
 }
 
+// openCreditAccount creates the credit account of a credit request, disbursing LONG_TERM loans,
+// and marks the request approved.
+func openCreditAccount(tx *gorm.DB, creditRequest *entities.CreditRequest, lateFeeRuleID uint) (*entities.CreditAccount, error) {
+	// 1. Create the CreditAccount entity from the request
+	creditAccount := entities.CreditAccount{ // Create CreditAccount entity
+		EstablishmentID:         creditRequest.EstablishmentID,
+		ClientID:                creditRequest.ClientID,
+		CreditLimit:             creditRequest.RequestedCreditLimit,
+		MonthlyDueDate:          creditRequest.MonthlyDueDate,
+		InterestRate:            creditRequest.InterestRate,
+		InterestType:            creditRequest.InterestType,
+		DayCount:                creditRequest.DayCount,
+		Compounding:             creditRequest.Compounding,
+		CreditType:              creditRequest.CreditType,
+		Term:                    creditRequest.Term,
+		AmortizationMethod:      creditRequest.AmortizationMethod,
+		GracePeriod:             creditRequest.GracePeriod,
+		GraceType:               creditRequest.GraceType,
+		IsBlocked:               false,
+		LastInterestAccrualDate: time.Now(),
+		CurrentBalance:          0, // Initial balance is 0
+		LateFeeRuleID:           lateFeeRuleID,
+	}
+
+	// 2. Save the credit account
+	if err := tx.Create(&creditAccount).Error; err != nil {
+		return nil, fmt.Errorf("error creating credit account: %w", err)
+	}
+
+	// 3. Disburse the loan and generate the amortization schedule for LONG_TERM credit
+	if err := disburse(tx, &creditAccount); err != nil {
+		return nil, err
+	}
+	if _, err := createSchedule(tx, &creditAccount, schedulePrincipal(&creditAccount, money.Zero), creditAccount.Term, 1, creditAccount.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	// 4. Update the credit request status
+	now := time.Now()
+	creditRequest.Status = entities.Approved
+	creditRequest.ApprovedAt = &now
+	if err := tx.Save(creditRequest).Error; err != nil {
+		return nil, fmt.Errorf("error updating credit request status: %w", err)
+	}
+
+	return &creditAccount, nil
+}
+
 func (r *creditAccountRepository) GetCreditRequestByID(id uint) (*entities.CreditRequest, error) {
 	var creditRequest entities.CreditRequest
 	if err := r.db.Preload("Establishment.Admin.User").Preload("Establishment.LateFeeRule").First(&creditRequest, id).Error; err != nil {
@@ -538,12 +1136,13 @@ func (r *creditAccountRepository) GetCreditRequestByID(id uint) (*entities.Credi
 	return &creditRequest, nil
 }
 
-func (r *creditAccountRepository) GetPendingCreditRequests(establishmentID uint) ([]entities.CreditRequest, error) {
-	var creditRequests []entities.CreditRequest
-	if err := r.db.Where("establishment_id = ? AND status = ?", establishmentID, enums.Pending).Find(&creditRequests).Error; err != nil {
+func (r *creditAccountRepository) GetPendingCreditRequests(establishmentID uint, query request.ListQuery) (*response.PageResponse[entities.CreditRequest], error) {
+	db := r.db.Where("establishment_id = ? AND status = ?", establishmentID, enums.Pending)
+	page, err := paginate[entities.CreditRequest](db, query, creditRequestListSpec)
+	if err != nil {
 		return nil, fmt.Errorf("error retrieving credit requests: %w", err)
 	}
-	return creditRequests, nil
+	return page, nil
 }
 
 func (r *creditAccountRepository) AssignCreditAccountToClient(creditAccountID, clientID uint) error {
@@ -567,3 +1166,337 @@ func (r *creditAccountRepository) AssignCreditAccountToClient(creditAccountID, c
 		return nil
 	})
 }
+
+// RegenerateSchedule rebuilds the unpaid installments of a LONG_TERM credit account from start,
+// optionally applying a new term or amortization method. Paid installments are kept as is.
+func (r *creditAccountRepository) RegenerateSchedule(creditAccountID uint, req request.RegenerateScheduleRequest, start time.Time) ([]response.InstallmentResponse, error) {
+	var installments []entities.Installment
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the credit account
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return err
+		}
+		if creditAccount.CreditType != enums.LongTerm {
+			return ErrNotLongTerm
+		}
+
+		// 2. Apply the new terms
+		if req.Term > 0 {
+			creditAccount.Term = req.Term
+		}
+		if req.AmortizationMethod != "" {
+			creditAccount.AmortizationMethod = req.AmortizationMethod
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account terms: %w", err)
+		}
+
+		// 3. Keep paid installments and drop the rest
+		var paid []entities.Installment
+		if err := tx.Where("credit_account_id = ? AND status = ?", creditAccountID, enums.Paid).Find(&paid).Error; err != nil {
+			return fmt.Errorf("error retrieving paid installments: %w", err)
+		}
+		if err := tx.Where("credit_account_id = ? AND status <> ?", creditAccountID, enums.Paid).Delete(&entities.Installment{}).Error; err != nil {
+			return fmt.Errorf("error deleting pending installments: %w", err)
+		}
+
+		var paidPrincipal money.Money
+		for _, installment := range paid {
+			paidPrincipal += installment.Principal
+		}
+		remainingTerm := creditAccount.Term - paidTermInstallments(&creditAccount, paid)
+		if remainingTerm <= 0 {
+			return errors.New("term must be longer than the number of paid installments")
+		}
+
+		// 4. Build the new schedule for the outstanding principal
+		var err error
+		installments, err = createSchedule(tx, &creditAccount, schedulePrincipal(&creditAccount, paidPrincipal), remainingTerm, len(paid)+1, start)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var installmentResponses []response.InstallmentResponse
+	for _, installment := range installments {
+		installmentResponses = append(installmentResponses, *getInstallmentResponse(&installment))
+	}
+
+	return installmentResponses, nil
+}
+
+var (
+	// ErrNotLongTerm is returned when a schedule is requested for a credit account that is not LONG_TERM.
+	ErrNotLongTerm = errors.New("amortization schedules are only available for LONG_TERM credit accounts")
+	// ErrArrearsOutstanding is returned when a prepayment is made on an account with amounts due.
+	ErrArrearsOutstanding = errors.New("credit account has amounts due that must be paid before prepaying")
+	// ErrInvalidPrepayment is returned when a prepayment amount or mode cannot be applied.
+	ErrInvalidPrepayment = errors.New("invalid prepayment")
+)
+
+// Prepay applies an EARLY_PAYMENT to a LONG_TERM account with no amounts due. The payment covers
+// the interest earned up to asOf and repays principal; the open installments are replaced by a
+// schedule for the remaining principal that keeps either the installment or the term, so the
+// interest they scheduled beyond asOf is rebated.
+func (r *creditAccountRepository) Prepay(creditAccountID uint, req request.PrepaymentRequest, asOf time.Time) (*response.PrepaymentResponse, error) {
+	result := response.PrepaymentResponse{Mode: req.Mode, Amount: req.Amount}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the credit account
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return err
+		}
+		if creditAccount.CreditType != enums.LongTerm {
+			return ErrNotLongTerm
+		}
+
+		// 2. Amounts due are settled with regular payments first
+		if creditAccount.FeesBalance.IsPositive() || creditAccount.PenaltyInterestBalance.IsPositive() || creditAccount.InterestBalance.IsPositive() {
+			return ErrArrearsOutstanding
+		}
+		var open []entities.Installment
+		if err := tx.Where("credit_account_id = ? AND status <> ?", creditAccountID, enums.Paid).Order("due_date, number").Find(&open).Error; err != nil {
+			return fmt.Errorf("error retrieving open installments: %w", err)
+		}
+		if len(open) > 0 && !finance.DateOf(open[0].DueDate).After(finance.DateOf(asOf)) {
+			return ErrArrearsOutstanding
+		}
+
+		// 3. Split the payment into the interest earned to date and principal
+		principal := finance.BalancesOf(creditAccount)[enums.PrincipalComponent]
+		if !principal.IsPositive() {
+			return fmt.Errorf("%w: no principal outstanding", ErrInvalidPrepayment)
+		}
+		var interest money.Money
+		if len(open) > 0 {
+			interest = finance.InterestToDate(creditAccount, principal, open[0], asOf)
+		}
+		if req.Amount <= interest {
+			return fmt.Errorf("%w: amount must exceed the interest earned to date (%s)", ErrInvalidPrepayment, interest)
+		}
+		if req.Amount > principal+interest {
+			return fmt.Errorf("%w: amount exceeds the payoff amount (%s)", ErrInvalidPrepayment, principal+interest)
+		}
+		if req.Mode == enums.ReduceTerm && creditAccount.AmortizationMethod == enums.Bullet && req.Amount < principal+interest {
+			return fmt.Errorf("%w: BULLET schedules can only reduce the installment", ErrInvalidPrepayment)
+		}
+		result.InterestPaid = interest
+		result.PrincipalPaid = req.Amount - interest
+		result.RemainingPrincipal = principal - result.PrincipalPaid
+
+		// 4. Charge the interest earned to date
+		if interest.IsPositive() {
+			description := fmt.Sprintf("Interest to %s for prepayment (%s)", finance.DateOf(asOf).Format("2006-01-02"), finance.TermsFor(creditAccount))
+			interestTransaction := entities.Transaction{
+				CreditAccountID: creditAccountID,
+				RecipientType:   enums.RolClient,
+				RecipientID:     creditAccount.ClientID,
+				TransactionType: enums.InterestAccrual,
+				Amount:          interest,
+				Description:     description,
+				TransactionDate: asOf,
+			}
+			if err := tx.Create(&interestTransaction).Error; err != nil {
+				return fmt.Errorf("error creating interest transaction: %w", err)
+			}
+			lines, _ := ledger.Lines(enums.InterestAccrual, interest, nil)
+			if _, err := postLedger(tx, &creditAccount, &interestTransaction, lines); err != nil {
+				return err
+			}
+			historyEntry := entities.CreditAccountHistory{
+				CreditAccountID: creditAccountID,
+				TransactionID:   &interestTransaction.ID,
+				TransactionDate: asOf,
+				TransactionType: enums.InterestAccrual,
+				Amount:          interest,
+				Balance:         creditAccount.CurrentBalance,
+				Description:     description,
+			}
+			if err := tx.Create(&historyEntry).Error; err != nil {
+				return fmt.Errorf("error creating credit account history: %w", err)
+			}
+		}
+
+		// 5. Post the prepayment and record how it was split
+		description := req.Description
+		if description == "" {
+			description = "Prepayment"
+		}
+		transaction := entities.Transaction{
+			CreditAccountID: creditAccountID,
+			RecipientType:   enums.RolEstablishment,
+			RecipientID:     creditAccount.EstablishmentID,
+			TransactionType: enums.EarlyPayment,
+			Amount:          req.Amount,
+			Description:     description,
+			TransactionDate: asOf,
+		}
+		if err := tx.Create(&transaction).Error; err != nil {
+			return fmt.Errorf("error creating prepayment transaction: %w", err)
+		}
+		allocation := finance.Balances{enums.InterestComponent: interest, enums.PrincipalComponent: result.PrincipalPaid}
+		lines, _ := ledger.Lines(enums.EarlyPayment, req.Amount, allocation)
+		if _, err := postLedger(tx, &creditAccount, &transaction, lines); err != nil {
+			return err
+		}
+		result.TransactionID = transaction.ID
+		var allocations []entities.PaymentAllocation
+		for _, component := range []enums.AllocationComponent{enums.InterestComponent, enums.PrincipalComponent} {
+			if amount := allocation[component]; amount.IsPositive() {
+				allocations = append(allocations, entities.PaymentAllocation{
+					TransactionID:   transaction.ID,
+					CreditAccountID: creditAccount.ID,
+					Component:       component,
+					Amount:          amount,
+				})
+			}
+		}
+		if err := tx.Create(&allocations).Error; err != nil {
+			return fmt.Errorf("error creating payment allocations: %w", err)
+		}
+
+		// 6. Replace the open installments with the schedule of the remaining principal
+		if err := tx.Where("credit_account_id = ? AND status <> ?", creditAccountID, enums.Paid).Delete(&entities.Installment{}).Error; err != nil {
+			return fmt.Errorf("error deleting open installments: %w", err)
+		}
+		var paid []entities.Installment
+		if err := tx.Where("credit_account_id = ? AND status = ?", creditAccountID, enums.Paid).Find(&paid).Error; err != nil {
+			return fmt.Errorf("error retrieving paid installments: %w", err)
+		}
+		paidInstallments := paidTermInstallments(&creditAccount, paid)
+		remainingTerm := max(creditAccount.Term-paidInstallments, 1)
+		if req.Mode == enums.ReduceTerm && result.RemainingPrincipal.IsPositive() {
+			terms := finance.ScheduleTermsFor(creditAccount, result.RemainingPrincipal, asOf)
+			remainingTerm = finance.TermForPayment(terms, finance.AmortizingInstallment(open), remainingTerm)
+			creditAccount.Term = paidInstallments + remainingTerm
+		}
+		installments, err := createSchedule(tx, &creditAccount, result.RemainingPrincipal, remainingTerm, len(paid)+1, asOf)
+		if err != nil {
+			return err
+		}
+		result.Term = creditAccount.Term
+		result.RebatedInterest = (finance.ScheduledInterest(open) - interest - finance.ScheduledInterest(installments)).Max(money.Zero)
+		for _, installment := range installments {
+			result.Installments = append(result.Installments, *getInstallmentResponse(&installment))
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account: %w", err)
+		}
+
+		// 7. Create a CreditAccountHistory record
+		historyEntry := entities.CreditAccountHistory{
+			CreditAccountID: creditAccountID,
+			TransactionID:   &transaction.ID,
+			TransactionDate: asOf,
+			TransactionType: enums.EarlyPayment,
+			Amount:          -req.Amount,
+			Balance:         creditAccount.CurrentBalance,
+			Description:     fmt.Sprintf("%s (%s, %s interest rebated)", description, req.Mode, result.RebatedInterest),
+		}
+		if err := tx.Create(&historyEntry).Error; err != nil {
+			return fmt.Errorf("error creating credit account history: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// paidTermInstallments counts the paid installments that count towards the term of an account.
+// Interest-only installments paid during the grace period do not.
+func paidTermInstallments(creditAccount *entities.CreditAccount, paid []entities.Installment) int {
+	count := 0
+	graceEnd := finance.GraceEnd(*creditAccount)
+	for _, installment := range paid {
+		if installment.DueDate.After(graceEnd) {
+			count++
+		}
+	}
+	return count
+}
+
+// schedulePrincipal returns the amount to amortize: the principal part of the balance when there
+// is one, otherwise the credit limit minus the principal already repaid.
+func schedulePrincipal(creditAccount *entities.CreditAccount, paidPrincipal money.Money) money.Money {
+	if principal := finance.BalancesOf(*creditAccount)[enums.PrincipalComponent]; principal.IsPositive() {
+		return principal
+	}
+	return creditAccount.CreditLimit - paidPrincipal
+}
+
+// disburse hands the credit limit of a new LONG_TERM account to the client, so that the
+// principal of the schedule is owed from day one. Accounts opened with a balance are left as is.
+func disburse(tx *gorm.DB, creditAccount *entities.CreditAccount) error {
+	if creditAccount.CreditType != enums.LongTerm || !creditAccount.CurrentBalance.IsZero() || !creditAccount.CreditLimit.IsPositive() {
+		return nil
+	}
+
+	now := time.Now()
+	transaction := entities.Transaction{
+		CreditAccountID: creditAccount.ID,
+		RecipientType:   enums.RolClient,
+		RecipientID:     creditAccount.ClientID,
+		TransactionType: enums.Disbursement,
+		Amount:          creditAccount.CreditLimit,
+		Description:     "Loan disbursement",
+		TransactionDate: now,
+	}
+	if err := tx.Create(&transaction).Error; err != nil {
+		return fmt.Errorf("error creating disbursement transaction: %w", err)
+	}
+
+	lines, _ := ledger.Lines(enums.Disbursement, transaction.Amount, nil)
+	if _, err := postLedger(tx, creditAccount, &transaction, lines); err != nil {
+		return err
+	}
+	if err := tx.Save(creditAccount).Error; err != nil {
+		return fmt.Errorf("error updating credit account balance: %w", err)
+	}
+
+	historyEntry := entities.CreditAccountHistory{
+		CreditAccountID: creditAccount.ID,
+		TransactionID:   &transaction.ID,
+		TransactionDate: now,
+		TransactionType: enums.Disbursement,
+		Amount:          creditAccount.CreditLimit,
+		Balance:         creditAccount.CurrentBalance,
+		Description:     transaction.Description,
+	}
+	if err := tx.Create(&historyEntry).Error; err != nil {
+		return fmt.Errorf("error creating credit account history record: %w", err)
+	}
+
+	return nil
+}
+
+// createSchedule stores the amortization schedule of a LONG_TERM credit account.
+// It does nothing for SHORT_TERM accounts or accounts without a term.
+func createSchedule(tx *gorm.DB, creditAccount *entities.CreditAccount, principal money.Money, term, firstNumber int, start time.Time) ([]entities.Installment, error) {
+	if creditAccount.CreditType != enums.LongTerm || term <= 0 {
+		return nil, nil
+	}
+
+	terms := finance.ScheduleTermsFor(*creditAccount, principal, start)
+	terms.Term = term
+	terms.FirstNumber = firstNumber
+	installments := finance.BuildSchedule(terms)
+	for i := range installments {
+		installments[i].CreditAccountID = creditAccount.ID
+	}
+
+	if len(installments) > 0 {
+		if err := tx.Create(&installments).Error; err != nil {
+			return nil, fmt.Errorf("error creating installments: %w", err)
+		}
+	}
+
+	return installments, nil
+}
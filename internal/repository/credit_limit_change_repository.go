@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrLimitBelowBalance is returned when a credit limit would drop below the balance of the
+	// account without an override.
+	ErrLimitBelowBalance = errors.New("credit limit cannot be lower than the current balance")
+	// ErrLimitChangeDecided is returned when a credit limit change was already approved or rejected.
+	ErrLimitChangeDecided = errors.New("credit limit change has already been decided")
+)
+
+// creditLimitChangeListSpec describes the list queries on credit limit changes.
+var creditLimitChangeListSpec = listSpec{
+	table:       "credit_limit_changes",
+	sorts:       map[string]string{"id": "id", "created_at": "created_at", "new_limit": "new_limit"},
+	defaultSort: "-created_at",
+	status:      "status",
+	date:        "created_at",
+	amount:      "new_limit",
+}
+
+// CreditLimitChangeRepository defines the interface for credit limit change repository operations.
+type CreditLimitChangeRepository interface {
+	Create(change *entities.CreditLimitChange) (*response.CreditLimitChangeResponse, error)
+	GetByID(id uint) (*entities.CreditLimitChange, error)
+	ListByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.CreditLimitChangeResponse], error)
+	HasPending(creditAccountID uint) (bool, error)
+	Approve(id uint, deciderUserID uint, override bool) (*response.CreditLimitChangeResponse, error)
+	Reject(id uint, deciderUserID uint) (*response.CreditLimitChangeResponse, error)
+}
+
+type creditLimitChangeRepository struct {
+	db *gorm.DB
+}
+
+// NewCreditLimitChangeRepository creates a new instance of creditLimitChangeRepository.
+func NewCreditLimitChangeRepository(db *gorm.DB) CreditLimitChangeRepository {
+	return &creditLimitChangeRepository{db: db}
+}
+
+// Create records a pending credit limit change.
+func (r *creditLimitChangeRepository) Create(change *entities.CreditLimitChange) (*response.CreditLimitChangeResponse, error) {
+	if err := r.db.Create(change).Error; err != nil {
+		return nil, fmt.Errorf("error creating credit limit change: %w", err)
+	}
+
+	return getCreditLimitChangeResponse(change), nil
+}
+
+// GetByID retrieves a credit limit change with its credit account.
+func (r *creditLimitChangeRepository) GetByID(id uint) (*entities.CreditLimitChange, error) {
+	var change entities.CreditLimitChange
+	if err := r.db.Preload("CreditAccount").First(&change, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// ListByCreditAccountID retrieves a page of the credit limit changes of a credit account.
+func (r *creditLimitChangeRepository) ListByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.CreditLimitChangeResponse], error) {
+	page, err := paginate[entities.CreditLimitChange](r.db.Where("credit_account_id = ?", creditAccountID), query, creditLimitChangeListSpec)
+	if err != nil {
+		return nil, err
+	}
+	return mapPage(page, getCreditLimitChangeResponse), nil
+}
+
+// HasPending reports whether a credit account has a credit limit change awaiting a decision.
+func (r *creditLimitChangeRepository) HasPending(creditAccountID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.CreditLimitChange{}).
+		Where("credit_account_id = ? AND status = ?", creditAccountID, entities.Pending).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("error checking for pending credit limit changes: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Approve applies a pending credit limit change to its credit account. Limits below the
+// balance of the account are only applied with an override.
+func (r *creditLimitChangeRepository) Approve(id uint, deciderUserID uint, override bool) (*response.CreditLimitChangeResponse, error) {
+	var change entities.CreditLimitChange
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the change and its credit account
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&change, id).Error; err != nil {
+			return err
+		}
+		if change.Status != entities.Pending {
+			return ErrLimitChangeDecided
+		}
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, change.CreditAccountID).Error; err != nil {
+			return fmt.Errorf("error retrieving credit account: %w", err)
+		}
+
+		// 2. Apply the new limit
+		change.OldLimit = creditAccount.CreditLimit
+		description := fmt.Sprintf("Credit limit change #%d approved", change.ID)
+		if err := applyCreditLimit(tx, &creditAccount, change.NewLimit, override, description); err != nil {
+			return err
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account: %w", err)
+		}
+
+		// 3. Close the change
+		now := time.Now()
+		change.Status = entities.Approved
+		change.Override = override && change.NewLimit < creditAccount.CurrentBalance
+		change.DecidedByUserID = deciderUserID
+		change.DecidedAt = &now
+		if err := tx.Save(&change).Error; err != nil {
+			return fmt.Errorf("error updating credit limit change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getCreditLimitChangeResponse(&change), nil
+}
+
+// Reject closes a pending credit limit change without applying it.
+func (r *creditLimitChangeRepository) Reject(id uint, deciderUserID uint) (*response.CreditLimitChangeResponse, error) {
+	var change entities.CreditLimitChange
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&change, id).Error; err != nil {
+			return err
+		}
+		if change.Status != entities.Pending {
+			return ErrLimitChangeDecided
+		}
+
+		now := time.Now()
+		change.Status = entities.Rejected
+		change.DecidedByUserID = deciderUserID
+		change.DecidedAt = &now
+		if err := tx.Save(&change).Error; err != nil {
+			return fmt.Errorf("error updating credit limit change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getCreditLimitChangeResponse(&change), nil
+}
+
+// applyCreditLimit sets the credit limit of a locked credit account and records the change in
+// its history as a CREDIT_LIMIT_INCREASE or CREDIT_LIMIT_DECREASE entry that leaves the balance
+// unchanged. Limits below the balance need an override. The caller saves the account.
+func applyCreditLimit(tx *gorm.DB, creditAccount *entities.CreditAccount, newLimit money.Money, override bool, description string) error {
+	oldLimit := creditAccount.CreditLimit
+	if newLimit == oldLimit {
+		return nil
+	}
+	if newLimit < creditAccount.CurrentBalance && !override {
+		return fmt.Errorf("%w (%s)", ErrLimitBelowBalance, creditAccount.CurrentBalance)
+	}
+
+	transactionType := enums.CreditLimitIncrease
+	if newLimit < oldLimit {
+		transactionType = enums.CreditLimitDecrease
+	}
+	creditAccount.CreditLimit = newLimit
+
+	historyEntry := entities.CreditAccountHistory{
+		CreditAccountID: creditAccount.ID,
+		TransactionDate: time.Now(),
+		TransactionType: transactionType,
+		Amount:          money.Zero,
+		Balance:         creditAccount.CurrentBalance,
+		Description:     description,
+		OldLimit:        &oldLimit,
+		NewLimit:        &newLimit,
+	}
+	if err := tx.Create(&historyEntry).Error; err != nil {
+		return fmt.Errorf("error creating credit account history: %w", err)
+	}
+	return nil
+}
+
+func getCreditLimitChangeResponse(change *entities.CreditLimitChange) *response.CreditLimitChangeResponse {
+	return &response.CreditLimitChangeResponse{
+		ID:                change.ID,
+		CreditAccountID:   change.CreditAccountID,
+		RequestedByUserID: change.RequestedByUserID,
+		OldLimit:          change.OldLimit,
+		NewLimit:          change.NewLimit,
+		Reason:            change.Reason,
+		Automatic:         change.Automatic,
+		Status:            change.Status,
+		Override:          change.Override,
+		DecidedByUserID:   change.DecidedByUserID,
+		DecidedAt:         change.DecidedAt,
+		CreatedAt:         change.CreatedAt,
+		UpdatedAt:         change.UpdatedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"errors"
+
+	"ApiRestFinance/internal/model/entities"
+	"gorm.io/gorm"
+)
+
+// CreditPolicyRepository defines the interface for credit policy repository operations.
+type CreditPolicyRepository interface {
+	GetByEstablishmentID(establishmentID uint) (*entities.CreditPolicy, error)
+	Save(policy *entities.CreditPolicy) error
+}
+
+type creditPolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewCreditPolicyRepository creates a new instance of creditPolicyRepository.
+func NewCreditPolicyRepository(db *gorm.DB) CreditPolicyRepository {
+	return &creditPolicyRepository{db: db}
+}
+
+// GetByEstablishmentID retrieves the credit policy of an establishment, nil when it has none.
+func (r *creditPolicyRepository) GetByEstablishmentID(establishmentID uint) (*entities.CreditPolicy, error) {
+	var policy entities.CreditPolicy
+	err := r.db.Where("establishment_id = ?", establishmentID).First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Save creates or updates a credit policy.
+func (r *creditPolicyRepository) Save(policy *entities.CreditPolicy) error {
+	return r.db.Save(policy).Error
+}
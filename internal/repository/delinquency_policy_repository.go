@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"errors"
+
+	"ApiRestFinance/internal/model/entities"
+	"gorm.io/gorm"
+)
+
+// DelinquencyPolicyRepository defines the interface for delinquency policy repository operations.
+type DelinquencyPolicyRepository interface {
+	GetByEstablishmentID(establishmentID uint) (*entities.DelinquencyPolicy, error)
+	Save(policy *entities.DelinquencyPolicy) error
+}
+
+type delinquencyPolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewDelinquencyPolicyRepository creates a new instance of delinquencyPolicyRepository.
+func NewDelinquencyPolicyRepository(db *gorm.DB) DelinquencyPolicyRepository {
+	return &delinquencyPolicyRepository{db: db}
+}
+
+// GetByEstablishmentID retrieves the delinquency policy of an establishment, nil when it has none.
+func (r *delinquencyPolicyRepository) GetByEstablishmentID(establishmentID uint) (*entities.DelinquencyPolicy, error) {
+	var policy entities.DelinquencyPolicy
+	err := r.db.Where("establishment_id = ?", establishmentID).First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Save creates or updates a delinquency policy.
+func (r *delinquencyPolicyRepository) Save(policy *entities.DelinquencyPolicy) error {
+	return r.db.Save(policy).Error
+}
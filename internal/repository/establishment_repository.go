@@ -3,7 +3,9 @@ package repository
 import (
 	"errors"
 
+	"ApiRestFinance/internal/finance"
 	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
 
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
@@ -38,16 +40,17 @@ func (r *establishmentRepository) GetAll() ([]response.EstablishmentResponse, er
 	var establishmentResponses []response.EstablishmentResponse
 	for _, establishment := range establishments {
 		establishmentResponses = append(establishmentResponses, response.EstablishmentResponse{
-			ID:        establishment.ID,
-			RUC:       establishment.RUC,
-			Name:      establishment.Name,
-			Phone:     establishment.Phone,
-			Address:   establishment.Address,
-			IsActive:  establishment.IsActive,
-			CreatedAt: establishment.CreatedAt,
-			UpdatedAt: establishment.UpdatedAt,
-			Admin:     getAdminResponse(establishment.Admin),
-			Products:  getEstablishmentProductsResponse(establishment.Products),
+			ID:                     establishment.ID,
+			RUC:                    establishment.RUC,
+			Name:                   establishment.Name,
+			Phone:                  establishment.Phone,
+			Address:                establishment.Address,
+			IsActive:               establishment.IsActive,
+			PaymentAllocationOrder: getAllocationOrder(establishment.PaymentAllocationOrder),
+			CreatedAt:              establishment.CreatedAt,
+			UpdatedAt:              establishment.UpdatedAt,
+			Admin:                  getAdminResponse(establishment.Admin),
+			Products:               getEstablishmentProductsResponse(establishment.Products),
 		})
 	}
 
@@ -62,16 +65,17 @@ func (r *establishmentRepository) GetByID(id uint) (*response.EstablishmentRespo
 	}
 
 	return &response.EstablishmentResponse{
-		ID:        establishment.ID,
-		RUC:       establishment.RUC,
-		Name:      establishment.Name,
-		Phone:     establishment.Phone,
-		Address:   establishment.Address,
-		IsActive:  establishment.IsActive,
-		CreatedAt: establishment.CreatedAt,
-		UpdatedAt: establishment.UpdatedAt,
-		Admin:     getAdminResponse(establishment.Admin),
-		Products:  getEstablishmentProductsResponse(establishment.Products),
+		ID:                     establishment.ID,
+		RUC:                    establishment.RUC,
+		Name:                   establishment.Name,
+		Phone:                  establishment.Phone,
+		Address:                establishment.Address,
+		IsActive:               establishment.IsActive,
+		PaymentAllocationOrder: getAllocationOrder(establishment.PaymentAllocationOrder),
+		CreatedAt:              establishment.CreatedAt,
+		UpdatedAt:              establishment.UpdatedAt,
+		Admin:                  getAdminResponse(establishment.Admin),
+		Products:               getEstablishmentProductsResponse(establishment.Products),
 	}, nil
 }
 
@@ -96,16 +100,17 @@ func (r *establishmentRepository) Create(req request.CreateEstablishmentRequest)
 	}
 
 	return &response.EstablishmentResponse{
-		ID:        establishment.ID,
-		RUC:       establishment.RUC,
-		Name:      establishment.Name,
-		Phone:     establishment.Phone,
-		Address:   establishment.Address,
-		IsActive:  establishment.IsActive,
-		CreatedAt: establishment.CreatedAt,
-		UpdatedAt: establishment.UpdatedAt,
-		Admin:     getAdminResponse(establishment.Admin),
-		Products:  []response.ProductResponse{},
+		ID:                     establishment.ID,
+		RUC:                    establishment.RUC,
+		Name:                   establishment.Name,
+		Phone:                  establishment.Phone,
+		Address:                establishment.Address,
+		IsActive:               establishment.IsActive,
+		PaymentAllocationOrder: getAllocationOrder(establishment.PaymentAllocationOrder),
+		CreatedAt:              establishment.CreatedAt,
+		UpdatedAt:              establishment.UpdatedAt,
+		Admin:                  getAdminResponse(establishment.Admin),
+		Products:               []response.ProductResponse{},
 	}, nil
 }
 
@@ -120,6 +125,13 @@ func (r *establishmentRepository) Update(id uint, req request.UpdateEstablishmen
 	establishment.Phone = req.Phone
 	establishment.Address = req.Address
 	establishment.IsActive = req.IsActive
+	if len(req.PaymentAllocationOrder) > 0 {
+		order, err := finance.ParseAllocationOrder(finance.FormatAllocationOrder(req.PaymentAllocationOrder))
+		if err != nil {
+			return nil, err
+		}
+		establishment.PaymentAllocationOrder = finance.FormatAllocationOrder(order)
+	}
 
 	err := r.db.Save(&establishment).Error
 	if err != nil {
@@ -127,16 +139,17 @@ func (r *establishmentRepository) Update(id uint, req request.UpdateEstablishmen
 	}
 
 	return &response.EstablishmentResponse{
-		ID:        establishment.ID,
-		RUC:       establishment.RUC,
-		Name:      establishment.Name,
-		Phone:     establishment.Phone,
-		Address:   establishment.Address,
-		IsActive:  establishment.IsActive,
-		CreatedAt: establishment.CreatedAt,
-		UpdatedAt: establishment.UpdatedAt,
-		Admin:     getAdminResponse(establishment.Admin),
-		Products:  getEstablishmentProductsResponse(establishment.Products),
+		ID:                     establishment.ID,
+		RUC:                    establishment.RUC,
+		Name:                   establishment.Name,
+		Phone:                  establishment.Phone,
+		Address:                establishment.Address,
+		IsActive:               establishment.IsActive,
+		PaymentAllocationOrder: getAllocationOrder(establishment.PaymentAllocationOrder),
+		CreatedAt:              establishment.CreatedAt,
+		UpdatedAt:              establishment.UpdatedAt,
+		Admin:                  getAdminResponse(establishment.Admin),
+		Products:               getEstablishmentProductsResponse(establishment.Products),
 	}, nil
 }
 
@@ -182,6 +195,15 @@ func getEstablishmentProductsResponse(products []entities.Product) []response.Pr
 	return productResponses
 }
 
+// getAllocationOrder returns the full payment allocation order stored for an establishment.
+func getAllocationOrder(value string) []enums.AllocationComponent {
+	order, err := finance.ParseAllocationOrder(value)
+	if err != nil {
+		return finance.DefaultAllocationOrder
+	}
+	return order
+}
+
 func getAdminResponse(admin *entities.Admin) *response.AdminResponse {
 	if admin == nil {
 		return nil
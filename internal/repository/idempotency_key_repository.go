@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyKeyRepository defines the interface for idempotency key repository operations.
+type IdempotencyKeyRepository interface {
+	Reserve(idempotencyKey *entities.IdempotencyKey) (bool, error)
+	Get(scope, key string) (*entities.IdempotencyKey, error)
+	Complete(idempotencyKey *entities.IdempotencyKey, statusCode int, contentType string, body []byte) error
+	Release(idempotencyKey *entities.IdempotencyKey) error
+}
+
+type idempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewIdempotencyKeyRepository creates a new instance of idempotencyKeyRepository.
+func NewIdempotencyKeyRepository(db *gorm.DB) IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{db: db}
+}
+
+// Reserve stores a new key. It returns false when the key was already used in the same scope,
+// the unique index makes concurrent retries race safely.
+func (r *idempotencyKeyRepository) Reserve(idempotencyKey *entities.IdempotencyKey) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(idempotencyKey)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// Get retrieves a key by scope and value.
+func (r *idempotencyKeyRepository) Get(scope, key string) (*entities.IdempotencyKey, error) {
+	var idempotencyKey entities.IdempotencyKey
+	err := r.db.Where("scope = ? AND key = ?", scope, key).First(&idempotencyKey).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+// Complete stores the response returned for the key.
+func (r *idempotencyKeyRepository) Complete(idempotencyKey *entities.IdempotencyKey, statusCode int, contentType string, body []byte) error {
+	now := time.Now()
+	idempotencyKey.StatusCode = statusCode
+	idempotencyKey.ContentType = contentType
+	idempotencyKey.ResponseBody = body
+	idempotencyKey.CompletedAt = &now
+
+	return r.db.Save(idempotencyKey).Error
+}
+
+// Release deletes a key so the request can be retried, e.g. after a server error.
+func (r *idempotencyKeyRepository) Release(idempotencyKey *entities.IdempotencyKey) error {
+	return r.db.Unscoped().Delete(idempotencyKey).Error
+}
@@ -9,6 +9,16 @@ import (
 	"time"
 )
 
+// installmentListSpec describes the list queries on installments.
+var installmentListSpec = listSpec{
+	table:       "installments",
+	sorts:       map[string]string{"id": "id", "number": "number", "due_date": "due_date", "amount": "amount"},
+	defaultSort: "due_date",
+	status:      "status",
+	date:        "due_date",
+	amount:      "amount",
+}
+
 // InstallmentRepository defines the interface for installment repository operations.
 type InstallmentRepository interface {
 	Create(req request.CreateInstallmentRequest) (*response.InstallmentResponse, error)
@@ -16,7 +26,8 @@ type InstallmentRepository interface {
 	Update(id uint, req request.UpdateInstallmentRequest) (*response.InstallmentResponse, error)
 	Delete(id uint) error
 	GetByCreditAccountID(creditAccountID uint) ([]response.InstallmentResponse, error)
-	GetOverdueInstallments(creditAccountID uint) ([]response.InstallmentResponse, error)
+	ListByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error)
+	GetOverdueInstallments(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error)
 }
 
 type installmentRepository struct {
@@ -96,7 +107,7 @@ func (r *installmentRepository) Delete(id uint) error {
 // GetByCreditAccountID retrieves all installments for a specific credit account.
 func (r *installmentRepository) GetByCreditAccountID(creditAccountID uint) ([]response.InstallmentResponse, error) {
 	var installments []entities.Installment
-	err := r.db.Where("credit_account_id = ?", creditAccountID).Find(&installments).Error
+	err := r.db.Where("credit_account_id = ?", creditAccountID).Order("due_date, number").Find(&installments).Error
 	if err != nil {
 		return nil, err
 	}
@@ -109,28 +120,38 @@ func (r *installmentRepository) GetByCreditAccountID(creditAccountID uint) ([]re
 	return installmentResponses, nil
 }
 
-// GetOverdueInstallments retrieves all overdue installments for a specific credit account.
-func (r *installmentRepository) GetOverdueInstallments(creditAccountID uint) ([]response.InstallmentResponse, error) {
-	var overdueInstallments []entities.Installment
-	err := r.db.Where("credit_account_id = ? AND due_date < ? AND status = ?", creditAccountID, time.Now(), enums.Overdue).Find(&overdueInstallments).Error
+// ListByCreditAccountID retrieves a page of the installments of a credit account.
+func (r *installmentRepository) ListByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error) {
+	page, err := paginate[entities.Installment](r.db.Where("credit_account_id = ?", creditAccountID), query, installmentListSpec)
 	if err != nil {
 		return nil, err
 	}
+	return mapPage(page, getInstallmentResponse), nil
+}
 
-	var overdueInstallmentResponses []response.InstallmentResponse
-	for _, installment := range overdueInstallments {
-		overdueInstallmentResponses = append(overdueInstallmentResponses, *getInstallmentResponse(&installment))
+// GetOverdueInstallments retrieves a page of the overdue installments of a credit account.
+func (r *installmentRepository) GetOverdueInstallments(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error) {
+	db := r.db.Where("credit_account_id = ? AND due_date < ? AND status = ?", creditAccountID, time.Now(), enums.Overdue)
+	page, err := paginate[entities.Installment](db, query, installmentListSpec)
+	if err != nil {
+		return nil, err
 	}
-
-	return overdueInstallmentResponses, nil
+	return mapPage(page, getInstallmentResponse), nil
 }
 
 func getInstallmentResponse(installment *entities.Installment) *response.InstallmentResponse {
 	return &response.InstallmentResponse{
 		ID:              installment.ID,
 		CreditAccountID: installment.CreditAccountID,
+		Number:          installment.Number,
 		DueDate:         installment.DueDate,
 		Amount:          installment.Amount,
+		Principal:       installment.Principal,
+		Interest:        installment.Interest,
+		Balance:         installment.Balance,
+		InterestPaid:    installment.InterestPaid,
+		PrincipalPaid:   installment.PrincipalPaid,
+		PaidAt:          installment.PaidAt,
 		Status:          installment.Status,
 	}
 }
@@ -8,13 +8,22 @@ import (
 	"time"
 )
 
+// lateFeeListSpec describes the list queries on late fees.
+var lateFeeListSpec = listSpec{
+	table:       "late_fees",
+	sorts:       map[string]string{"id": "id", "applied_date": "applied_date", "amount": "amount"},
+	defaultSort: "applied_date",
+	date:        "applied_date",
+	amount:      "amount",
+}
+
 // LateFeeRepository defines the interface for late fee repository operations.
 type LateFeeRepository interface {
 	Create(req request.CreateLateFeeRequest) (*response.LateFeeResponse, error)
 	GetByID(id uint) (*response.LateFeeResponse, error)
 	Update(id uint, req request.UpdateLateFeeRequest) (*response.LateFeeResponse, error)
 	Delete(id uint) error
-	GetByCreditAccountID(creditAccountID uint) ([]response.LateFeeResponse, error)
+	GetByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeResponse], error)
 }
 
 type lateFeeRepository struct {
@@ -84,20 +93,13 @@ func (r *lateFeeRepository) Delete(id uint) error {
 	return r.db.Delete(&lateFee).Error
 }
 
-// GetByCreditAccountID retrieves all late fees for a specific credit account.
-func (r *lateFeeRepository) GetByCreditAccountID(creditAccountID uint) ([]response.LateFeeResponse, error) {
-	var lateFees []entities.LateFee
-	err := r.db.Where("credit_account_id = ?", creditAccountID).Find(&lateFees).Error
+// GetByCreditAccountID retrieves a page of the late fees of a credit account.
+func (r *lateFeeRepository) GetByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeResponse], error) {
+	page, err := paginate[entities.LateFee](r.db.Where("credit_account_id = ?", creditAccountID), query, lateFeeListSpec)
 	if err != nil {
 		return nil, err
 	}
-
-	var lateFeeResponses []response.LateFeeResponse
-	for _, lateFee := range lateFees {
-		lateFeeResponses = append(lateFeeResponses, *getLateFeeResponse(&lateFee))
-	}
-
-	return lateFeeResponses, nil
+	return mapPage(page, getLateFeeResponse), nil
 }
 
 func getLateFeeResponse(lateFee *entities.LateFee) *response.LateFeeResponse {
@@ -7,14 +7,21 @@ import (
 	"gorm.io/gorm"
 )
 
+// lateFeeRuleListSpec describes the list queries on late fee rules.
+var lateFeeRuleListSpec = listSpec{
+	table:       "late_fee_rules",
+	sorts:       map[string]string{"id": "id", "name": "name", "days_overdue_min": "days_overdue_min"},
+	defaultSort: "id",
+}
+
 // LateFeeRuleRepository defines the interface for late fee rule repository operations.
 type LateFeeRuleRepository interface {
 	Create(req request.CreateLateFeeRuleRequest) (*response.LateFeeRuleResponse, error)
 	GetByID(id uint) (*response.LateFeeRuleResponse, error)
 	Update(id uint, req request.UpdateLateFeeRuleRequest) (*response.LateFeeRuleResponse, error)
 	Delete(id uint) error
-	GetAll() ([]response.LateFeeRuleResponse, error)
-	GetByEstablishmentID(establishmentID uint) ([]response.LateFeeRuleResponse, error)
+	GetAll(query request.ListQuery, tenant Tenant) (*response.PageResponse[response.LateFeeRuleResponse], error)
+	GetByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeRuleResponse], error)
 }
 
 type lateFeeRuleRepository struct {
@@ -99,36 +106,22 @@ func (r *lateFeeRuleRepository) Delete(id uint) error {
 	return r.db.Delete(&lateFeeRule).Error
 }
 
-// GetAll retrieves all late fee rules.
-func (r *lateFeeRuleRepository) GetAll() ([]response.LateFeeRuleResponse, error) {
-	var lateFeeRules []entities.LateFeeRule
-	err := r.db.Find(&lateFeeRules).Error
+// GetAll retrieves a page of the late fee rules visible to a tenant.
+func (r *lateFeeRuleRepository) GetAll(query request.ListQuery, tenant Tenant) (*response.PageResponse[response.LateFeeRuleResponse], error) {
+	page, err := paginate[entities.LateFeeRule](r.db.Scopes(tenantScope(tenant, LateFeeRuleResource)), query, lateFeeRuleListSpec)
 	if err != nil {
 		return nil, err
 	}
-
-	var lateFeeRuleResponses []response.LateFeeRuleResponse
-	for _, rule := range lateFeeRules {
-		lateFeeRuleResponses = append(lateFeeRuleResponses, *getLateFeeRuleResponse(&rule))
-	}
-
-	return lateFeeRuleResponses, nil
+	return mapPage(page, getLateFeeRuleResponse), nil
 }
 
-// GetByEstablishmentID retrieves all late fee rules for a specific establishment.
-func (r *lateFeeRuleRepository) GetByEstablishmentID(establishmentID uint) ([]response.LateFeeRuleResponse, error) {
-	var lateFeeRules []entities.LateFeeRule
-	err := r.db.Where("establishment_id = ?", establishmentID).Find(&lateFeeRules).Error
+// GetByEstablishmentID retrieves a page of the late fee rules of an establishment.
+func (r *lateFeeRuleRepository) GetByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeRuleResponse], error) {
+	page, err := paginate[entities.LateFeeRule](r.db.Where("establishment_id = ?", establishmentID), query, lateFeeRuleListSpec)
 	if err != nil {
 		return nil, err
 	}
-
-	var lateFeeRuleResponses []response.LateFeeRuleResponse
-	for _, rule := range lateFeeRules {
-		lateFeeRuleResponses = append(lateFeeRuleResponses, *getLateFeeRuleResponse(&rule))
-	}
-
-	return lateFeeRuleResponses, nil
+	return mapPage(page, getLateFeeRuleResponse), nil
 }
 
 func getLateFeeRuleResponse(rule *entities.LateFeeRule) *response.LateFeeRuleResponse {
@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/ledger"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// journalEntryListSpec describes the list queries on journal entries.
+var journalEntryListSpec = listSpec{
+	table:       "journal_entries",
+	sorts:       map[string]string{"id": "id", "entry_date": "entry_date"},
+	defaultSort: "entry_date",
+	date:        "entry_date",
+}
+
+// LedgerRepository defines the interface for the general ledger queries.
+type LedgerRepository interface {
+	GetChart(establishmentID uint) ([]response.LedgerAccountResponse, error)
+	GetTrialBalance(establishmentID uint, asOf time.Time) (*response.TrialBalanceResponse, error)
+	GetJournalEntries(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.JournalEntryResponse], error)
+	GetLedgerBalance(creditAccountID uint) (*response.LedgerBalanceResponse, error)
+}
+
+type ledgerRepository struct {
+	db *gorm.DB
+}
+
+// NewLedgerRepository creates a new instance of ledgerRepository.
+func NewLedgerRepository(db *gorm.DB) LedgerRepository {
+	return &ledgerRepository{db: db}
+}
+
+// GetChart retrieves the chart of accounts of an establishment, creating it on first use.
+func (r *ledgerRepository) GetChart(establishmentID uint) ([]response.LedgerAccountResponse, error) {
+	var establishment entities.Establishment
+	if err := r.db.Select("id").First(&establishment, establishmentID).Error; err != nil {
+		return nil, err
+	}
+
+	if _, err := ensureChart(r.db, establishmentID); err != nil {
+		return nil, err
+	}
+
+	var accounts []entities.LedgerAccount
+	if err := r.db.Where("establishment_id = ?", establishmentID).Order("code").Find(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving ledger accounts: %w", err)
+	}
+
+	accountResponses := make([]response.LedgerAccountResponse, 0, len(accounts))
+	for _, account := range accounts {
+		accountResponses = append(accountResponses, response.LedgerAccountResponse{
+			ID:              account.ID,
+			EstablishmentID: account.EstablishmentID,
+			Code:            account.Code,
+			Name:            account.Name,
+			Type:            account.Type,
+		})
+	}
+
+	return accountResponses, nil
+}
+
+// GetTrialBalance sums the journal lines of an establishment posted up to asOf, per ledger account.
+func (r *ledgerRepository) GetTrialBalance(establishmentID uint, asOf time.Time) (*response.TrialBalanceResponse, error) {
+	chart, err := r.GetChart(establishmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var totals []struct {
+		LedgerAccountID uint
+		Debit           money.Money
+		Credit          money.Money
+	}
+	if err := r.db.Model(&entities.JournalLine{}).
+		Select("journal_lines.ledger_account_id, COALESCE(SUM(journal_lines.debit), 0) AS debit, COALESCE(SUM(journal_lines.credit), 0) AS credit").
+		Joins("JOIN journal_entries ON journal_entries.id = journal_lines.journal_entry_id AND journal_entries.deleted_at IS NULL").
+		Where("journal_entries.establishment_id = ? AND journal_entries.entry_date <= ?", establishmentID, asOf).
+		Group("journal_lines.ledger_account_id").
+		Scan(&totals).Error; err != nil {
+		return nil, fmt.Errorf("error summing journal lines: %w", err)
+	}
+
+	trialBalance := &response.TrialBalanceResponse{
+		EstablishmentID: establishmentID,
+		AsOf:            asOf,
+		Accounts:        make([]response.TrialBalanceLineResponse, 0, len(chart)),
+	}
+	for _, account := range chart {
+		line := response.TrialBalanceLineResponse{Code: account.Code, Name: account.Name, Type: account.Type}
+		for _, total := range totals {
+			if total.LedgerAccountID == account.ID {
+				line.Debit, line.Credit = total.Debit, total.Credit
+			}
+		}
+		line.Balance = line.Credit - line.Debit
+		if account.Type.DebitNormal() {
+			line.Balance = line.Debit - line.Credit
+		}
+		trialBalance.TotalDebit += line.Debit
+		trialBalance.TotalCredit += line.Credit
+		trialBalance.Accounts = append(trialBalance.Accounts, line)
+	}
+	trialBalance.Balanced = trialBalance.TotalDebit == trialBalance.TotalCredit
+
+	return trialBalance, nil
+}
+
+// GetJournalEntries retrieves a page of the journal entries posted for a credit account, oldest
+// first unless the query sorts them otherwise.
+func (r *ledgerRepository) GetJournalEntries(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.JournalEntryResponse], error) {
+	db := r.db.Where("credit_account_id = ?", creditAccountID).Preload("Lines.LedgerAccount")
+	page, err := paginate[entities.JournalEntry](db, query, journalEntryListSpec)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving journal entries: %w", err)
+	}
+	return mapPage(page, getJournalEntryResponse), nil
+}
+
+// GetLedgerBalance validates the balances stored on a credit account against its receivables in the ledger.
+func (r *ledgerRepository) GetLedgerBalance(creditAccountID uint) (*response.LedgerBalanceResponse, error) {
+	var creditAccount entities.CreditAccount
+	if err := r.db.First(&creditAccount, creditAccountID).Error; err != nil {
+		return nil, err
+	}
+
+	ledgerBalances, err := receivableBalances(r.db, creditAccountID)
+	if err != nil {
+		return nil, err
+	}
+	accountBalances := finance.BalancesOf(creditAccount)
+
+	ledgerBalance := &response.LedgerBalanceResponse{
+		CreditAccountID: creditAccountID,
+		CurrentBalance:  creditAccount.CurrentBalance,
+		Balanced:        true,
+	}
+	for _, component := range finance.DefaultAllocationOrder {
+		difference := accountBalances[component] - ledgerBalances[component]
+		ledgerBalance.LedgerBalance += ledgerBalances[component]
+		ledgerBalance.Components = append(ledgerBalance.Components, response.LedgerComponentResponse{
+			Component:      component,
+			AccountBalance: accountBalances[component],
+			LedgerBalance:  ledgerBalances[component],
+			Difference:     difference,
+		})
+		if !difference.IsZero() {
+			ledgerBalance.Balanced = false
+		}
+	}
+	ledgerBalance.Difference = ledgerBalance.CurrentBalance - ledgerBalance.LedgerBalance
+
+	return ledgerBalance, nil
+}
+
+// receivableBalances sums what the journal says a credit account owes, per debt component.
+func receivableBalances(db *gorm.DB, creditAccountID uint) (finance.Balances, error) {
+	var totals []struct {
+		Code   string
+		Debit  money.Money
+		Credit money.Money
+	}
+	if err := db.Model(&entities.JournalLine{}).
+		Select("ledger_accounts.code, COALESCE(SUM(journal_lines.debit), 0) AS debit, COALESCE(SUM(journal_lines.credit), 0) AS credit").
+		Joins("JOIN journal_entries ON journal_entries.id = journal_lines.journal_entry_id AND journal_entries.deleted_at IS NULL").
+		Joins("JOIN ledger_accounts ON ledger_accounts.id = journal_lines.ledger_account_id").
+		Where("journal_entries.credit_account_id = ? AND ledger_accounts.code IN ?", creditAccountID, ledger.Receivables()).
+		Group("ledger_accounts.code").
+		Scan(&totals).Error; err != nil {
+		return nil, fmt.Errorf("error summing receivables: %w", err)
+	}
+
+	balances := finance.Balances{}
+	for _, total := range totals {
+		if component, ok := ledger.ComponentOf(ledger.AccountCode(total.Code)); ok {
+			balances[component] = total.Debit - total.Credit
+		}
+	}
+	return balances, nil
+}
+
+// ensureChart creates the missing accounts of the chart of accounts of an establishment
+// and returns the IDs of all of them by code.
+func ensureChart(tx *gorm.DB, establishmentID uint) (map[ledger.AccountCode]uint, error) {
+	var accounts []entities.LedgerAccount
+	if err := tx.Where("establishment_id = ?", establishmentID).Find(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving ledger accounts: %w", err)
+	}
+
+	if len(accounts) < len(ledger.Chart) {
+		missing := make([]entities.LedgerAccount, 0, len(ledger.Chart))
+		for _, account := range ledger.Chart {
+			missing = append(missing, entities.LedgerAccount{
+				EstablishmentID: establishmentID,
+				Code:            string(account.Code),
+				Name:            account.Name,
+				Type:            account.Type,
+			})
+		}
+		// Concurrent postings may create the chart at the same time
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&missing).Error; err != nil {
+			return nil, fmt.Errorf("error creating ledger accounts: %w", err)
+		}
+		accounts = nil
+		if err := tx.Where("establishment_id = ?", establishmentID).Find(&accounts).Error; err != nil {
+			return nil, fmt.Errorf("error retrieving ledger accounts: %w", err)
+		}
+	}
+
+	ids := make(map[ledger.AccountCode]uint, len(accounts))
+	for _, account := range accounts {
+		ids[ledger.AccountCode(account.Code)] = account.ID
+	}
+	return ids, nil
+}
+
+// postLedger writes the journal entry of a transaction and applies its receivable lines to the
+// balance and debt components of the credit account, which the caller saves. It returns the
+// change in the balance.
+func postLedger(tx *gorm.DB, creditAccount *entities.CreditAccount, transaction *entities.Transaction, lines []ledger.Line) (money.Money, error) {
+	if !ledger.Balanced(lines) {
+		return money.Zero, ledger.ErrUnbalanced
+	}
+
+	accounts, err := ensureChart(tx, creditAccount.EstablishmentID)
+	if err != nil {
+		return money.Zero, err
+	}
+
+	entry := entities.JournalEntry{
+		EstablishmentID: creditAccount.EstablishmentID,
+		CreditAccountID: creditAccount.ID,
+		TransactionID:   transaction.ID,
+		EntryDate:       transaction.TransactionDate,
+		Description:     transaction.Description,
+	}
+	if err := tx.Create(&entry).Error; err != nil {
+		return money.Zero, fmt.Errorf("error creating journal entry: %w", err)
+	}
+
+	journalLines := make([]entities.JournalLine, 0, len(lines))
+	for _, line := range lines {
+		accountID, ok := accounts[line.Account]
+		if !ok {
+			return money.Zero, fmt.Errorf("ledger account %s not found", line.Account)
+		}
+		journalLines = append(journalLines, entities.JournalLine{
+			JournalEntryID:  entry.ID,
+			LedgerAccountID: accountID,
+			Debit:           line.Debit,
+			Credit:          line.Credit,
+		})
+	}
+	if err := tx.Create(&journalLines).Error; err != nil {
+		return money.Zero, fmt.Errorf("error creating journal lines: %w", err)
+	}
+
+	change := ledger.ReceivableChange(lines)
+	creditAccount.FeesBalance += change[enums.FeesComponent]
+	creditAccount.PenaltyInterestBalance += change[enums.PenaltyInterestComponent]
+	creditAccount.InterestBalance += change[enums.InterestComponent]
+	var total money.Money
+	for _, amount := range change {
+		total += amount
+	}
+	creditAccount.CurrentBalance += total
+
+	return total, nil
+}
+
+// journalLinesOf returns the lines posted for a transaction, or false when it was posted
+// before the ledger existed.
+func journalLinesOf(tx *gorm.DB, transactionID uint) ([]ledger.Line, bool, error) {
+	var entry entities.JournalEntry
+	err := tx.Where("transaction_id = ?", transactionID).Preload("Lines.LedgerAccount").First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error retrieving journal entry: %w", err)
+	}
+
+	sort.Slice(entry.Lines, func(i, j int) bool { return entry.Lines[i].ID < entry.Lines[j].ID })
+	lines := make([]ledger.Line, 0, len(entry.Lines))
+	for _, line := range entry.Lines {
+		lines = append(lines, ledger.Line{
+			Account: ledger.AccountCode(line.LedgerAccount.Code),
+			Debit:   line.Debit,
+			Credit:  line.Credit,
+		})
+	}
+	return lines, true, nil
+}
+
+func getJournalEntryResponse(entry *entities.JournalEntry) *response.JournalEntryResponse {
+	entryResponse := &response.JournalEntryResponse{
+		ID:              entry.ID,
+		EstablishmentID: entry.EstablishmentID,
+		CreditAccountID: entry.CreditAccountID,
+		TransactionID:   entry.TransactionID,
+		EntryDate:       entry.EntryDate,
+		Description:     entry.Description,
+	}
+	for _, line := range entry.Lines {
+		entryResponse.Lines = append(entryResponse.Lines, response.JournalLineResponse{
+			AccountCode: line.LedgerAccount.Code,
+			AccountName: line.LedgerAccount.Name,
+			Debit:       line.Debit,
+			Credit:      line.Credit,
+		})
+	}
+	return entryResponse
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"gorm.io/gorm"
+)
+
+// defaultListLimit is the page size of list queries that do not set one.
+const defaultListLimit = 50
+
+// ErrInvalidListQuery is returned when a list query sorts by an unsupported field or carries
+// a malformed cursor.
+var ErrInvalidListQuery = errors.New("invalid list query")
+
+// listSpec describes how list queries apply to a table. Filters without a column are ignored.
+type listSpec struct {
+	table       string
+	sorts       map[string]string // Sort parameter to column
+	defaultSort string            // Sort parameter used when the query sets none
+	status      string            // Column compared with the status filter
+	date        string            // Column compared with the from and to filters
+	amount      string            // Column compared with the amount range
+	creditType  string            // Column compared with the credit type filter
+}
+
+// filters restricts a query to the rows matching the filters the spec supports.
+func (s listSpec) filters(query request.ListQuery) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if s.status != "" && query.Status != "" {
+			db = db.Where(s.column(s.status)+" = ?", query.Status)
+		}
+		if s.date != "" && query.From != nil {
+			db = db.Where(s.column(s.date)+" >= ?", *query.From)
+		}
+		if s.date != "" && query.To != nil {
+			db = db.Where(s.column(s.date)+" < ?", query.To.AddDate(0, 0, 1))
+		}
+		if s.amount != "" && query.MinAmount != nil {
+			db = db.Where(s.column(s.amount)+" >= ?", *query.MinAmount)
+		}
+		if s.amount != "" && query.MaxAmount != nil {
+			db = db.Where(s.column(s.amount)+" <= ?", *query.MaxAmount)
+		}
+		if s.creditType != "" && query.CreditType != "" {
+			db = db.Where(s.column(s.creditType)+" = ?", query.CreditType)
+		}
+		return db
+	}
+}
+
+// column qualifies a column with the table of the spec.
+func (s listSpec) column(name string) string {
+	return s.table + "." + name
+}
+
+// sortColumn returns the column and direction of the sort parameter of a query.
+func (s listSpec) sortColumn(sort string) (string, bool, error) {
+	if sort == "" {
+		sort = s.defaultSort
+	}
+	descending := strings.HasPrefix(sort, "-")
+	column, ok := s.sorts[strings.TrimPrefix(sort, "-")]
+	if !ok {
+		return "", false, fmt.Errorf("%w: cannot sort by %q", ErrInvalidListQuery, strings.TrimPrefix(sort, "-"))
+	}
+	return column, descending, nil
+}
+
+// paginate retrieves the page of a list query, with the total of rows matching its filters.
+// Offset pagination skips rows; cursor pagination resumes after the last row of the previous
+// page, so rows inserted meanwhile do not shift the pages. Ties are ordered by ID.
+func paginate[T any](db *gorm.DB, query request.ListQuery, spec listSpec) (*response.PageResponse[T], error) {
+	column, descending, err := spec.sortColumn(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	db = db.Model(new(T)).Scopes(spec.filters(query)).Session(&gorm.Session{})
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("error counting %s: %w", spec.table, err)
+	}
+
+	direction, comparison := "ASC", ">"
+	if descending {
+		direction, comparison = "DESC", "<"
+	}
+	order := fmt.Sprintf("%s %s", spec.column(column), direction)
+	if column != "id" {
+		order += fmt.Sprintf(", %s %s", spec.column("id"), direction)
+	}
+	pageDB := db.Order(order).Limit(limit + 1)
+	offset := query.Offset
+	if query.Cursor != "" {
+		value, id, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if column == "id" {
+			pageDB = pageDB.Where(fmt.Sprintf("%s %s ?", spec.column("id"), comparison), id)
+		} else {
+			pageDB = pageDB.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", spec.column(column), spec.column("id"), comparison), value, id)
+		}
+		offset = 0
+	} else {
+		pageDB = pageDB.Offset(offset)
+	}
+
+	var items []T
+	if err := pageDB.Find(&items).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving %s: %w", spec.table, err)
+	}
+	page := &response.PageResponse[T]{Items: items, Total: total, Limit: limit, Offset: offset}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		if page.NextCursor, err = encodeCursor(db, &items[limit-1], column); err != nil {
+			return nil, err
+		}
+	}
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+	return page, nil
+}
+
+// mapPage converts the items of a page.
+func mapPage[T, R any](page *response.PageResponse[T], convert func(*T) *R) *response.PageResponse[R] {
+	items := make([]R, 0, len(page.Items))
+	for i := range page.Items {
+		items = append(items, *convert(&page.Items[i]))
+	}
+	return &response.PageResponse[R]{Items: items, Total: page.Total, Limit: page.Limit, Offset: page.Offset, NextCursor: page.NextCursor}
+}
+
+// listCursor is the position of a row in a sorted list.
+type listCursor struct {
+	Value interface{} `json:"v"`
+	ID    interface{} `json:"id"`
+}
+
+// encodeCursor returns the cursor of the page that starts after an item.
+func encodeCursor(db *gorm.DB, item interface{}, column string) (string, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(item); err != nil {
+		return "", fmt.Errorf("error building cursor: %w", err)
+	}
+	field := stmt.Schema.LookUpField(column)
+	if field == nil || stmt.Schema.PrioritizedPrimaryField == nil {
+		return "", fmt.Errorf("error building cursor: unknown column %s", column)
+	}
+
+	row := reflect.ValueOf(item)
+	value, _ := field.ValueOf(db.Statement.Context, row)
+	if valuer, ok := value.(driver.Valuer); ok {
+		var err error
+		if value, err = valuer.Value(); err != nil {
+			return "", fmt.Errorf("error building cursor: %w", err)
+		}
+	}
+	if t, ok := value.(time.Time); ok {
+		value = t.UTC().Format(time.RFC3339Nano)
+	}
+	id, _ := stmt.Schema.PrioritizedPrimaryField.ValueOf(db.Statement.Context, row)
+
+	data, err := json.Marshal(listCursor{Value: value, ID: id})
+	if err != nil {
+		return "", fmt.Errorf("error building cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor returns the sort value and ID stored in a cursor. Numbers are returned as their
+// text, which the database converts to the type of the column.
+func decodeCursor(cursor string) (interface{}, interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed cursor", ErrInvalidListQuery)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var position listCursor
+	if err := decoder.Decode(&position); err != nil || position.ID == nil {
+		return nil, nil, fmt.Errorf("%w: malformed cursor", ErrInvalidListQuery)
+	}
+	return cursorValue(position.Value), cursorValue(position.ID), nil
+}
+
+func cursorValue(value interface{}) interface{} {
+	if number, ok := value.(json.Number); ok {
+		return number.String()
+	}
+	return value
+}
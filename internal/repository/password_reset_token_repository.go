@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"gorm.io/gorm"
+)
+
+// ErrResetTokenInvalid is returned for a password reset token that does not exist, expired or
+// was already used.
+var ErrResetTokenInvalid = errors.New("password reset token is invalid or expired")
+
+// PasswordResetTokenRepository defines the interface for password reset token repository operations.
+type PasswordResetTokenRepository interface {
+	Create(resetToken *entities.PasswordResetToken) error
+	Consume(tokenHash string, now time.Time) (*entities.PasswordResetToken, error)
+}
+
+type passwordResetTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetTokenRepository creates a new instance of passwordResetTokenRepository.
+func NewPasswordResetTokenRepository(db *gorm.DB) PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{db: db}
+}
+
+// Create stores a new token and supersedes the unused tokens of the user, so only the latest
+// email works.
+func (r *passwordResetTokenRepository) Create(resetToken *entities.PasswordResetToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entities.PasswordResetToken{}).
+			Where("user_id = ? AND used_at IS NULL", resetToken.UserID).
+			Update("used_at", time.Now()).Error
+		if err != nil {
+			return fmt.Errorf("error superseding password reset tokens: %w", err)
+		}
+		if err := tx.Create(resetToken).Error; err != nil {
+			return fmt.Errorf("error creating password reset token: %w", err)
+		}
+		return nil
+	})
+}
+
+// Consume marks a valid token as used and returns it. The conditional update makes concurrent
+// uses of the same token race safely, only one of them succeeds.
+func (r *passwordResetTokenRepository) Consume(tokenHash string, now time.Time) (*entities.PasswordResetToken, error) {
+	var resetToken entities.PasswordResetToken
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("token_hash = ?", tokenHash).First(&resetToken).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrResetTokenInvalid
+			}
+			return fmt.Errorf("error retrieving password reset token: %w", err)
+		}
+
+		result := tx.Model(&entities.PasswordResetToken{}).
+			Where("id = ? AND used_at IS NULL AND expires_at > ?", resetToken.ID, now).
+			Update("used_at", now)
+		if result.Error != nil {
+			return fmt.Errorf("error consuming password reset token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrResetTokenInvalid
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resetToken.UsedAt = &now
+	return &resetToken, nil
+}
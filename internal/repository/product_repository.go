@@ -10,10 +10,20 @@ import (
 	"gorm.io/gorm"
 )
 
+// productListSpec describes the list queries on products.
+var productListSpec = listSpec{
+	table:       "products",
+	sorts:       map[string]string{"id": "id", "name": "name", "price": "price", "stock": "stock", "created_at": "created_at"},
+	defaultSort: "id",
+	status:      "category",
+	date:        "created_at",
+	amount:      "price",
+}
+
 type ProductRepository interface {
-	GetAll() ([]response.ProductResponse, error)
+	GetAll(query request.ListQuery, tenant Tenant) (*response.PageResponse[response.ProductResponse], error)
 	GetByID(id uint) (*response.ProductResponse, error)
-	GetByEstablishmentID(establishmentID uint) ([]response.ProductResponse, error)
+	GetByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.ProductResponse], error)
 	Create(req request.CreateProductRequest) (*response.ProductResponse, error)
 	Update(id uint, req request.UpdateProductRequest) (*response.ProductResponse, error)
 	Delete(id uint) error
@@ -27,30 +37,13 @@ func NewProductRepository(db *gorm.DB) ProductRepository {
 	return &productRepository{db: db}
 }
 
-func (r *productRepository) GetAll() ([]response.ProductResponse, error) {
-	var products []entities.Product
-	err := r.db.Preload("Establishment").Find(&products).Error
+// GetAll retrieves a page of the products visible to a tenant.
+func (r *productRepository) GetAll(query request.ListQuery, tenant Tenant) (*response.PageResponse[response.ProductResponse], error) {
+	page, err := paginate[entities.Product](r.db.Scopes(tenantScope(tenant, ProductResource)).Preload("Establishment"), query, productListSpec)
 	if err != nil {
 		return nil, err
 	}
-
-	var productResponses []response.ProductResponse
-	for _, product := range products {
-		productResponses = append(productResponses, response.ProductResponse{
-			ID:            product.ID,
-			Name:          product.Name,
-			Description:   product.Description,
-			Price:         product.Price,
-			Category:      product.Category,
-			Stock:         product.Stock,
-			IsActive:      product.IsActive,
-			CreatedAt:     product.CreatedAt,
-			UpdatedAt:     product.UpdatedAt,
-			Establishment: getEstablishmentResponse(&product.Establishment),
-		})
-	}
-
-	return productResponses, nil
+	return mapPage(page, getProductResponse), nil
 }
 
 func (r *productRepository) GetByID(id uint) (*response.ProductResponse, error) {
@@ -74,30 +67,12 @@ func (r *productRepository) GetByID(id uint) (*response.ProductResponse, error)
 	}, nil
 }
 
-func (r *productRepository) GetByEstablishmentID(establishmentID uint) ([]response.ProductResponse, error) {
-	var products []entities.Product
-	err := r.db.Preload("Establishment").Where("establishment_id = ?", establishmentID).Find(&products).Error
+func (r *productRepository) GetByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.ProductResponse], error) {
+	page, err := paginate[entities.Product](r.db.Preload("Establishment").Where("establishment_id = ?", establishmentID), query, productListSpec)
 	if err != nil {
 		return nil, err
 	}
-
-	var productResponses []response.ProductResponse
-	for _, product := range products {
-		productResponses = append(productResponses, response.ProductResponse{
-			ID:            product.ID,
-			Name:          product.Name,
-			Description:   product.Description,
-			Price:         product.Price,
-			Category:      product.Category,
-			Stock:         product.Stock,
-			IsActive:      product.IsActive,
-			CreatedAt:     product.CreatedAt,
-			UpdatedAt:     product.UpdatedAt,
-			Establishment: getEstablishmentResponse(&product.Establishment),
-		})
-	}
-
-	return productResponses, nil
+	return mapPage(page, getProductResponse), nil
 }
 
 func (r *productRepository) Create(req request.CreateProductRequest) (*response.ProductResponse, error) {
@@ -180,6 +155,21 @@ func (r *productRepository) Delete(id uint) error {
 	return r.db.Delete(&product).Error
 }
 
+func getProductResponse(product *entities.Product) *response.ProductResponse {
+	return &response.ProductResponse{
+		ID:            product.ID,
+		Name:          product.Name,
+		Description:   product.Description,
+		Price:         product.Price,
+		Category:      product.Category,
+		Stock:         product.Stock,
+		IsActive:      product.IsActive,
+		CreatedAt:     product.CreatedAt,
+		UpdatedAt:     product.UpdatedAt,
+		Establishment: getEstablishmentResponse(&product.Establishment),
+	}
+}
+
 func getEstablishmentResponse(establishment *entities.Establishment) *response.EstablishmentResponse {
 	if establishment == nil {
 		return nil
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/ledger"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReconciliationRepository defines the interface for balance reconciliation operations.
+type ReconciliationRepository interface {
+	GetCreditAccountIDs(establishmentID uint) ([]uint, error)
+	Reconcile(creditAccountID uint, repair bool) (*response.AccountReconciliationResponse, error)
+}
+
+type reconciliationRepository struct {
+	db *gorm.DB
+}
+
+// NewReconciliationRepository creates a new instance of reconciliationRepository.
+func NewReconciliationRepository(db *gorm.DB) ReconciliationRepository {
+	return &reconciliationRepository{db: db}
+}
+
+// GetCreditAccountIDs retrieves the IDs of the credit accounts of an establishment, or of every
+// establishment when establishmentID is 0.
+func (r *reconciliationRepository) GetCreditAccountIDs(establishmentID uint) ([]uint, error) {
+	query := r.db.Model(&entities.CreditAccount{}).Order("id")
+	if establishmentID != 0 {
+		query = query.Where("establishment_id = ?", establishmentID)
+	}
+
+	var ids []uint
+	if err := query.Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving credit accounts: %w", err)
+	}
+	return ids, nil
+}
+
+// Reconcile rebuilds the balance of a credit account from its history and compares it with the
+// stored balance, its transactions and the ledger. With repair, a difference between the stored
+// balance and the history is corrected with an ADJUSTMENT.
+func (r *reconciliationRepository) Reconcile(creditAccountID uint, repair bool) (*response.AccountReconciliationResponse, error) {
+	var result *response.AccountReconciliationResponse
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the credit account so no posting happens while it is checked
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return err
+		}
+
+		// 2. Rebuild the balance from the history, the transactions and the ledger
+		var historyBalance money.Money
+		if err := tx.Model(&entities.CreditAccountHistory{}).
+			Where("credit_account_id = ?", creditAccountID).
+			Select("COALESCE(SUM(amount), 0)").
+			Scan(&historyBalance).Error; err != nil {
+			return fmt.Errorf("error summing credit account history: %w", err)
+		}
+
+		var transactions []entities.Transaction
+		if err := tx.Where("credit_account_id = ?", creditAccountID).Find(&transactions).Error; err != nil {
+			return fmt.Errorf("error retrieving transactions: %w", err)
+		}
+		transactionBalance := ledger.TransactionBalance(transactions)
+
+		receivables, err := receivableBalances(tx, creditAccountID)
+		if err != nil {
+			return err
+		}
+		var ledgerBalance money.Money
+		for _, balance := range receivables {
+			ledgerBalance += balance
+		}
+
+		result = &response.AccountReconciliationResponse{
+			CreditAccountID:       creditAccountID,
+			EstablishmentID:       creditAccount.EstablishmentID,
+			CurrentBalance:        creditAccount.CurrentBalance,
+			HistoryBalance:        historyBalance,
+			TransactionBalance:    transactionBalance,
+			LedgerBalance:         ledgerBalance,
+			Difference:            historyBalance - creditAccount.CurrentBalance,
+			TransactionDifference: transactionBalance - historyBalance,
+			LedgerDifference:      ledgerBalance - historyBalance,
+		}
+		if !repair || result.Difference.IsZero() {
+			return nil
+		}
+
+		// 3. Correct the stored balance with an ADJUSTMENT and its journal entry
+		now := time.Now()
+		description := fmt.Sprintf("Balance reconciled from %s to %s", creditAccount.CurrentBalance, historyBalance)
+		adjustment := entities.Transaction{
+			CreditAccountID: creditAccountID,
+			RecipientType:   enums.RolClient,
+			RecipientID:     creditAccount.ClientID,
+			TransactionType: enums.Adjustment,
+			Amount:          result.Difference,
+			Description:     description,
+			TransactionDate: now,
+			ReasonCode:      enums.ReconciliationReason,
+		}
+		if err := tx.Create(&adjustment).Error; err != nil {
+			return fmt.Errorf("error creating reconciliation adjustment: %w", err)
+		}
+		lines, _ := ledger.Lines(enums.Adjustment, result.Difference, nil)
+		if _, err := postLedger(tx, &creditAccount, &adjustment, lines); err != nil {
+			return err
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account balance: %w", err)
+		}
+
+		// The history already adds up to the corrected balance, so the entry records the
+		// correction without changing that sum
+		historyEntry := entities.CreditAccountHistory{
+			CreditAccountID: creditAccountID,
+			TransactionID:   &adjustment.ID,
+			TransactionDate: now,
+			TransactionType: enums.Adjustment,
+			Amount:          money.Zero,
+			Balance:         creditAccount.CurrentBalance,
+			Description:     description,
+		}
+		if err := tx.Create(&historyEntry).Error; err != nil {
+			return fmt.Errorf("error creating credit account history: %w", err)
+		}
+
+		result.Adjusted = true
+		result.AdjustmentID = &adjustment.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
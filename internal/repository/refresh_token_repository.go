@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"gorm.io/gorm"
+)
+
+// ErrRefreshTokenReused is returned when a refresh token that was already rotated or revoked
+// is presented again, which means it may have been stolen.
+var ErrRefreshTokenReused = errors.New("refresh token has already been used")
+
+// RefreshTokenRepository defines the interface for refresh token repository operations.
+type RefreshTokenRepository interface {
+	Create(refreshToken *entities.RefreshToken) error
+	GetByHash(tokenHash string) (*entities.RefreshToken, error)
+	Rotate(current, next *entities.RefreshToken) error
+	RevokeFamily(familyID string) error
+	RevokeAllForUser(userID uint) error
+}
+
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository creates a new instance of refreshTokenRepository.
+func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+// Create stores a new refresh token.
+func (r *refreshTokenRepository) Create(refreshToken *entities.RefreshToken) error {
+	if err := r.db.Create(refreshToken).Error; err != nil {
+		return fmt.Errorf("error creating refresh token: %w", err)
+	}
+	return nil
+}
+
+// GetByHash retrieves a refresh token by the hash of its ID.
+func (r *refreshTokenRepository) GetByHash(tokenHash string) (*entities.RefreshToken, error) {
+	var refreshToken entities.RefreshToken
+	if err := r.db.Where("token_hash = ?", tokenHash).First(&refreshToken).Error; err != nil {
+		return nil, err
+	}
+	return &refreshToken, nil
+}
+
+// Rotate revokes the current token and stores the next one in its place. It returns
+// ErrRefreshTokenReused when the current token was revoked meanwhile, e.g. by a concurrent refresh.
+func (r *refreshTokenRepository) Rotate(current, next *entities.RefreshToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(next).Error; err != nil {
+			return fmt.Errorf("error creating refresh token: %w", err)
+		}
+
+		result := tx.Model(&entities.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", current.ID).
+			Updates(map[string]interface{}{"revoked_at": time.Now(), "replaced_by_id": next.ID})
+		if result.Error != nil {
+			return fmt.Errorf("error revoking refresh token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrRefreshTokenReused
+		}
+		return nil
+	})
+}
+
+// RevokeFamily revokes every token of a session.
+func (r *refreshTokenRepository) RevokeFamily(familyID string) error {
+	err := r.db.Model(&entities.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return fmt.Errorf("error revoking refresh tokens: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllForUser revokes every token of every session of a user.
+func (r *refreshTokenRepository) RevokeAllForUser(userID uint) error {
+	err := r.db.Model(&entities.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return fmt.Errorf("error revoking refresh tokens: %w", err)
+	}
+	return nil
+}
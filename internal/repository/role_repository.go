@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// legacyUserRole is the role RegisterUser assigned before the permission model existed.
+const legacyUserRole = "user"
+
+var (
+	// ErrUnknownRole is returned when a role name does not exist.
+	ErrUnknownRole = errors.New("unknown role")
+	// ErrMissingEstablishment is returned when a cashier is not assigned to an establishment.
+	ErrMissingEstablishment = errors.New("cashiers must be assigned to an establishment")
+)
+
+// RoleRepository defines the interface for role repository operations.
+type RoleRepository interface {
+	EnsureRoles() error
+	GetAll() ([]entities.Role, error)
+	GetByNames(names []enums.RoleName) ([]entities.Role, error)
+	GrantRole(userID uint, name enums.RoleName) error
+	SetUserRoles(userID uint, names []enums.RoleName, establishmentID *uint) (*entities.User, error)
+}
+
+type roleRepository struct {
+	db *gorm.DB
+}
+
+// NewRoleRepository creates a new instance of roleRepository.
+func NewRoleRepository(db *gorm.DB) RoleRepository {
+	return &roleRepository{db: db}
+}
+
+// EnsureRoles creates the roles of the permission model. The legacy "user" role becomes
+// the client role, so existing users keep the access of a client.
+func (r *roleRepository) EnsureRoles() error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var client entities.Role
+		err := tx.Where("name = ?", enums.ClientRole).First(&client).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := tx.Model(&entities.Role{}).Where("name = ?", legacyUserRole).Update("name", enums.ClientRole).Error; err != nil {
+				return fmt.Errorf("error renaming legacy role: %w", err)
+			}
+		} else if err != nil {
+			return fmt.Errorf("error retrieving role: %w", err)
+		}
+
+		roles := make([]entities.Role, 0, len(enums.RoleNames))
+		for _, name := range enums.RoleNames {
+			roles = append(roles, entities.Role{Name: string(name)})
+		}
+		if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).Create(&roles).Error; err != nil {
+			return fmt.Errorf("error creating roles: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetAll retrieves every role.
+func (r *roleRepository) GetAll() ([]entities.Role, error) {
+	var roles []entities.Role
+	if err := r.db.Order("id").Find(&roles).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving roles: %w", err)
+	}
+	return roles, nil
+}
+
+// GetByNames retrieves the roles with the given names.
+func (r *roleRepository) GetByNames(names []enums.RoleName) ([]entities.Role, error) {
+	var roles []entities.Role
+	if err := r.db.Where("name IN ?", names).Find(&roles).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving roles: %w", err)
+	}
+
+	found := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		found[role.Name] = true
+	}
+	for _, name := range names {
+		if !found[string(name)] {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownRole, name)
+		}
+	}
+	return roles, nil
+}
+
+// GrantRole adds a role to a user, keeping the ones it already has.
+func (r *roleRepository) GrantRole(userID uint, name enums.RoleName) error {
+	roles, err := r.GetByNames([]enums.RoleName{name})
+	if err != nil {
+		return err
+	}
+
+	user := entities.User{Model: gorm.Model{ID: userID}}
+	if err := r.db.Model(&user).Association("Roles").Append(&roles); err != nil {
+		return fmt.Errorf("error granting role %s: %w", name, err)
+	}
+	return nil
+}
+
+// SetUserRoles replaces the roles of a user and the establishment it works at as a cashier.
+func (r *roleRepository) SetUserRoles(userID uint, names []enums.RoleName, establishmentID *uint) (*entities.User, error) {
+	for _, name := range names {
+		if name == enums.CashierRole && establishmentID == nil {
+			return nil, ErrMissingEstablishment
+		}
+	}
+
+	roles, err := r.GetByNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	var user entities.User
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&user, userID).Error; err != nil {
+			return err
+		}
+		if establishmentID != nil {
+			if err := tx.First(&entities.Establishment{}, *establishmentID).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&user).Update("establishment_id", establishmentID).Error; err != nil {
+			return fmt.Errorf("error updating user establishment: %w", err)
+		}
+		if err := tx.Model(&user).Association("Roles").Replace(&roles); err != nil {
+			return fmt.Errorf("error updating user roles: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user.Roles = roles
+	return &user, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"gorm.io/gorm"
+)
+
+// ScheduledJobRepository defines the interface for scheduled job repository operations.
+type ScheduledJobRepository interface {
+	Register(name, description, cronExpression string) (*entities.ScheduledJob, error)
+	GetAll() ([]entities.ScheduledJob, error)
+	GetByName(name string) (*entities.ScheduledJob, error)
+	SetPaused(name string, paused bool) (*entities.ScheduledJob, error)
+	MarkRun(jobID uint, at time.Time) error
+	StartRun(jobID, establishmentID uint, trigger enums.JobTrigger) (*entities.JobRun, error)
+	FinishRun(run *entities.JobRun, runErr error) error
+	GetRuns(jobID uint, limit int) ([]entities.JobRun, error)
+}
+
+type scheduledJobRepository struct {
+	db *gorm.DB
+}
+
+// NewScheduledJobRepository creates a new instance of scheduledJobRepository.
+func NewScheduledJobRepository(db *gorm.DB) ScheduledJobRepository {
+	return &scheduledJobRepository{db: db}
+}
+
+// Register creates the job if it does not exist yet, otherwise it updates its schedule.
+// The paused flag is kept so a restart does not resume a job an admin paused.
+func (r *scheduledJobRepository) Register(name, description, cronExpression string) (*entities.ScheduledJob, error) {
+	var job entities.ScheduledJob
+	err := r.db.Where("name = ?", name).First(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		job = entities.ScheduledJob{Name: name, Description: description, CronExpression: cronExpression}
+		if err := r.db.Create(&job).Error; err != nil {
+			return nil, err
+		}
+		return &job, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	job.Description = description
+	job.CronExpression = cronExpression
+	if err := r.db.Save(&job).Error; err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// GetAll retrieves all scheduled jobs.
+func (r *scheduledJobRepository) GetAll() ([]entities.ScheduledJob, error) {
+	var jobs []entities.ScheduledJob
+	err := r.db.Order("name").Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// GetByName retrieves a scheduled job by name.
+func (r *scheduledJobRepository) GetByName(name string) (*entities.ScheduledJob, error) {
+	var job entities.ScheduledJob
+	err := r.db.Where("name = ?", name).First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// SetPaused pauses or resumes a scheduled job.
+func (r *scheduledJobRepository) SetPaused(name string, paused bool) (*entities.ScheduledJob, error) {
+	job, err := r.GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	job.IsPaused = paused
+	if err := r.db.Save(job).Error; err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// MarkRun records the time a job last ran.
+func (r *scheduledJobRepository) MarkRun(jobID uint, at time.Time) error {
+	return r.db.Model(&entities.ScheduledJob{}).Where("id = ?", jobID).Update("last_run_at", at).Error
+}
+
+// StartRun records that a job started for an establishment.
+func (r *scheduledJobRepository) StartRun(jobID, establishmentID uint, trigger enums.JobTrigger) (*entities.JobRun, error) {
+	run := entities.JobRun{
+		ScheduledJobID:  jobID,
+		EstablishmentID: establishmentID,
+		Trigger:         trigger,
+		Status:          enums.JobRunning,
+		StartedAt:       time.Now(),
+	}
+	if err := r.db.Create(&run).Error; err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+// FinishRun stores the outcome of a job run.
+func (r *scheduledJobRepository) FinishRun(run *entities.JobRun, runErr error) error {
+	now := time.Now()
+	run.FinishedAt = &now
+	run.Status = enums.JobSucceeded
+	if runErr != nil {
+		run.Status = enums.JobFailed
+		run.Error = runErr.Error()
+	}
+
+	return r.db.Save(run).Error
+}
+
+// GetRuns retrieves the latest runs of a job.
+func (r *scheduledJobRepository) GetRuns(jobID uint, limit int) ([]entities.JobRun, error) {
+	var runs []entities.JobRun
+	err := r.db.Where("scheduled_job_id = ?", jobID).Order("started_at DESC").Limit(limit).Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
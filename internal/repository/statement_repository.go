@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrNoCycleToClose is returned when the latest billing cycle of an account already has a statement.
+var ErrNoCycleToClose = errors.New("no billing cycle to close")
+
+// ErrNotShortTerm is returned when a statement is requested for a credit account that is not SHORT_TERM.
+var ErrNotShortTerm = errors.New("statements are only available for SHORT_TERM credit accounts")
+
+// statementListSpec describes the list queries on statements.
+var statementListSpec = listSpec{
+	table:       "statements",
+	sorts:       map[string]string{"id": "id", "period_end": "period_end", "closing_balance": "closing_balance"},
+	defaultSort: "-period_end",
+	date:        "period_end",
+	amount:      "closing_balance",
+}
+
+// StatementRepository defines the interface for statement repository operations.
+type StatementRepository interface {
+	CloseCycle(creditAccountID uint, asOf time.Time) (*response.StatementResponse, error)
+	GetByID(id uint) (*response.StatementResponse, error)
+	GetByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.StatementResponse], error)
+	GetCreditAccount(creditAccountID uint) (*entities.CreditAccount, error)
+	GetHistory(creditAccountID uint, from, to time.Time) ([]entities.CreditAccountHistory, error)
+	GetBalanceBefore(creditAccountID uint, date time.Time) (money.Money, error)
+}
+
+type statementRepository struct {
+	db *gorm.DB
+}
+
+// NewStatementRepository creates a new instance of statementRepository.
+func NewStatementRepository(db *gorm.DB) StatementRepository {
+	return &statementRepository{db: db}
+}
+
+// CloseCycle closes the latest billing cycle of a credit account that ended on or before asOf
+// and stores its statement.
+func (r *statementRepository) CloseCycle(creditAccountID uint, asOf time.Time) (*response.StatementResponse, error) {
+	var statement entities.Statement
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the credit account so no movement is posted while closing
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return err
+		}
+		if creditAccount.CreditType != enums.ShortTerm {
+			return ErrNotShortTerm
+		}
+
+		// 2. Work out the period: it starts the day after the previous closing date
+		closing, due := finance.BillingCycle(creditAccount.MonthlyDueDate, asOf)
+		periodStart := finance.DateOf(creditAccount.CreatedAt)
+		opening := money.Zero
+
+		var previous entities.Statement
+		err := tx.Where("credit_account_id = ?", creditAccountID).Order("period_end DESC").First(&previous).Error
+		switch {
+		case err == nil:
+			periodStart = previous.PeriodEnd.AddDate(0, 0, 1)
+			opening = previous.ClosingBalance
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return fmt.Errorf("error retrieving previous statement: %w", err)
+		}
+		if periodStart.After(closing) {
+			return ErrNoCycleToClose
+		}
+
+		// 3. Snapshot the movements of the period
+		var history []entities.CreditAccountHistory
+		if err := tx.Where("credit_account_id = ? AND transaction_date >= ? AND transaction_date < ?", creditAccountID, periodStart, closing.AddDate(0, 0, 1)).
+			Order("transaction_date, id").Find(&history).Error; err != nil {
+			return fmt.Errorf("error retrieving credit account history: %w", err)
+		}
+
+		// 4. Store the statement
+		statement = finance.BuildStatement(creditAccountID, periodStart, closing, due, opening, history)
+		if err := tx.Create(&statement).Error; err != nil {
+			return fmt.Errorf("error creating statement: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getStatementResponse(&statement), nil
+}
+
+// GetByID retrieves a statement by ID.
+func (r *statementRepository) GetByID(id uint) (*response.StatementResponse, error) {
+	var statement entities.Statement
+	err := r.db.First(&statement, id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return getStatementResponse(&statement), nil
+}
+
+// GetByCreditAccountID retrieves a page of the statements of a credit account, latest first
+// unless the query sorts them otherwise.
+func (r *statementRepository) GetByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.StatementResponse], error) {
+	page, err := paginate[entities.Statement](r.db.Where("credit_account_id = ?", creditAccountID), query, statementListSpec)
+	if err != nil {
+		return nil, err
+	}
+	return mapPage(page, getStatementResponse), nil
+}
+
+// GetCreditAccount retrieves a credit account with the establishment and client data printed on statements.
+func (r *statementRepository) GetCreditAccount(creditAccountID uint) (*entities.CreditAccount, error) {
+	var creditAccount entities.CreditAccount
+	err := r.db.Preload("Establishment").Preload("Client.User").First(&creditAccount, creditAccountID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &creditAccount, nil
+}
+
+// GetHistory retrieves the history rows of a credit account dated in [from, to).
+func (r *statementRepository) GetHistory(creditAccountID uint, from, to time.Time) ([]entities.CreditAccountHistory, error) {
+	var history []entities.CreditAccountHistory
+	err := r.db.Where("credit_account_id = ? AND transaction_date >= ? AND transaction_date < ?", creditAccountID, from, to).
+		Order("transaction_date, id").Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// GetBalanceBefore returns the balance of a credit account right before date, taken from its last history row.
+func (r *statementRepository) GetBalanceBefore(creditAccountID uint, date time.Time) (money.Money, error) {
+	var last entities.CreditAccountHistory
+	err := r.db.Where("credit_account_id = ? AND transaction_date < ?", creditAccountID, date).
+		Order("transaction_date DESC, id DESC").First(&last).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return money.Zero, nil
+	}
+	if err != nil {
+		return money.Zero, err
+	}
+
+	return last.Balance, nil
+}
+
+func getStatementResponse(statement *entities.Statement) *response.StatementResponse {
+	return &response.StatementResponse{
+		ID:              statement.ID,
+		CreditAccountID: statement.CreditAccountID,
+		PeriodStart:     statement.PeriodStart,
+		PeriodEnd:       statement.PeriodEnd,
+		DueDate:         statement.DueDate,
+		OpeningBalance:  statement.OpeningBalance,
+		Purchases:       statement.Purchases,
+		Payments:        statement.Payments,
+		Interest:        statement.Interest,
+		Fees:            statement.Fees,
+		Adjustments:     statement.Adjustments,
+		ClosingBalance:  statement.ClosingBalance,
+		AmountDue:       statement.AmountDue,
+		CreatedAt:       statement.CreatedAt,
+	}
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"fmt"
+
+	"ApiRestFinance/internal/model/entities"
+	"gorm.io/gorm"
+)
+
+// Tenant is the part of the data a caller may access. Establishment admins and cashiers are
+// bound to the establishment they work at, clients to their own accounts and requests. The
+// zero Tenant, used for platform admins and background jobs, is unrestricted.
+type Tenant struct {
+	EstablishmentID uint // Establishment of a staff caller
+	ClientUserID    uint // User of a client caller, set when EstablishmentID is not
+}
+
+// Unrestricted reports whether the tenant may access the data of every establishment.
+func (t Tenant) Unrestricted() bool {
+	return t.EstablishmentID == 0 && t.ClientUserID == 0
+}
+
+// Resource is a kind of record owned by an establishment.
+type Resource int
+
+const (
+	EstablishmentResource Resource = iota
+	ClientResource
+	CreditAccountResource
+	CreditRequestResource
+	TransactionResource
+	InstallmentResource
+	LateFeeResource
+	LateFeeRuleResource
+	ProductResource
+	CreditLimitChangeResource
+	ApplicantResource // Clients with a credit request or account at the establishment, who may be added to it
+)
+
+// TenantRepository defines the interface for tenant ownership checks.
+type TenantRepository interface {
+	Owns(tenant Tenant, resource Resource, id uint) error
+}
+
+type tenantRepository struct {
+	db *gorm.DB
+}
+
+// NewTenantRepository creates a new instance of tenantRepository.
+func NewTenantRepository(db *gorm.DB) TenantRepository {
+	return &tenantRepository{db: db}
+}
+
+// Owns returns gorm.ErrRecordNotFound when the record does not exist or belongs to another
+// tenant, so callers answer both cases the same way.
+func (r *tenantRepository) Owns(tenant Tenant, resource Resource, id uint) error {
+	var count int64
+	query := r.db.Model(resourceModel(resource)).Scopes(tenantScope(tenant, resource)).Where("id = ?", id)
+	if err := query.Count(&count).Error; err != nil {
+		return fmt.Errorf("error checking tenant ownership: %w", err)
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// resourceModel returns the model of the table that stores a resource.
+func resourceModel(resource Resource) interface{} {
+	switch resource {
+	case EstablishmentResource:
+		return &entities.Establishment{}
+	case ClientResource, ApplicantResource:
+		return &entities.Client{}
+	case CreditAccountResource:
+		return &entities.CreditAccount{}
+	case CreditRequestResource:
+		return &entities.CreditRequest{}
+	case TransactionResource:
+		return &entities.Transaction{}
+	case InstallmentResource:
+		return &entities.Installment{}
+	case LateFeeResource:
+		return &entities.LateFee{}
+	case LateFeeRuleResource:
+		return &entities.LateFeeRule{}
+	case CreditLimitChangeResource:
+		return &entities.CreditLimitChange{}
+	default:
+		return &entities.Product{}
+	}
+}
+
+// tenantScope restricts a query on the table of a resource to the records of a tenant. Clients
+// may browse every establishment and its products, since they buy from any of them.
+func tenantScope(tenant Tenant, resource Resource) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if tenant.Unrestricted() {
+			return db
+		}
+		sub := db.Session(&gorm.Session{NewDB: true})
+		staff := tenant.EstablishmentID != 0
+		clients := sub.Model(&entities.Client{}).Select("id").Where("user_id = ?", tenant.ClientUserID)
+		accounts := sub.Model(&entities.CreditAccount{}).Select("id").Scopes(tenantScope(tenant, CreditAccountResource))
+
+		switch resource {
+		case EstablishmentResource:
+			if staff {
+				return db.Where("establishments.id = ?", tenant.EstablishmentID)
+			}
+			return db
+		case ClientResource:
+			if staff {
+				members := sub.Table("establishment_clients").Select("client_id").Where("establishment_id = ?", tenant.EstablishmentID)
+				return db.Where("clients.id IN (?)", members)
+			}
+			return db.Where("clients.user_id = ?", tenant.ClientUserID)
+		case ApplicantResource:
+			if staff {
+				requests := sub.Model(&entities.CreditRequest{}).Select("client_id").Where("establishment_id = ?", tenant.EstablishmentID)
+				accounts := sub.Model(&entities.CreditAccount{}).Select("client_id").Where("establishment_id = ?", tenant.EstablishmentID)
+				return db.Where("clients.id IN (?) OR clients.id IN (?)", requests, accounts)
+			}
+			return db.Where("clients.user_id = ?", tenant.ClientUserID)
+		case CreditAccountResource:
+			if staff {
+				return db.Where("credit_accounts.establishment_id = ?", tenant.EstablishmentID)
+			}
+			return db.Where("credit_accounts.client_id IN (?)", clients)
+		case CreditRequestResource:
+			if staff {
+				return db.Where("credit_requests.establishment_id = ?", tenant.EstablishmentID)
+			}
+			return db.Where("credit_requests.client_id IN (?)", clients)
+		case TransactionResource:
+			return db.Where("transactions.credit_account_id IN (?)", accounts)
+		case InstallmentResource:
+			return db.Where("installments.credit_account_id IN (?)", accounts)
+		case LateFeeResource:
+			return db.Where("late_fees.credit_account_id IN (?)", accounts)
+		case CreditLimitChangeResource:
+			return db.Where("credit_limit_changes.credit_account_id IN (?)", accounts)
+		case LateFeeRuleResource:
+			if staff {
+				return db.Where("late_fee_rules.establishment_id = ?", tenant.EstablishmentID)
+			}
+			return db.Where("FALSE")
+		default:
+			if staff {
+				return db.Where("products.establishment_id = ?", tenant.EstablishmentID)
+			}
+			return db
+		}
+	}
+}
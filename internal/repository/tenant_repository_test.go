@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// sqlRecorder keeps the statements gorm would run, with their values inlined.
+type sqlRecorder struct {
+	statements []string
+}
+
+func (r *sqlRecorder) LogMode(logger.LogLevel) logger.Interface      { return r }
+func (r *sqlRecorder) Info(context.Context, string, ...interface{})  {}
+func (r *sqlRecorder) Warn(context.Context, string, ...interface{})  {}
+func (r *sqlRecorder) Error(context.Context, string, ...interface{}) {}
+
+func (r *sqlRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+// dryRunDB returns a database that builds statements without a server and records them.
+func dryRunDB(t *testing.T) (*gorm.DB, *sqlRecorder) {
+	t.Helper()
+	recorder := &sqlRecorder{}
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               recorder,
+	})
+	if err != nil {
+		t.Fatalf("error opening dry run database: %v", err)
+	}
+	return db, recorder
+}
+
+// requireEvery fails unless every recorded statement contains want.
+func requireEvery(t *testing.T, recorder *sqlRecorder, want string) {
+	t.Helper()
+	if len(recorder.statements) == 0 {
+		t.Fatal("no statement was built")
+	}
+	for _, sql := range recorder.statements {
+		if !strings.Contains(sql, want) {
+			t.Errorf("statement is not scoped by %q: %s", want, sql)
+		}
+	}
+}
+
+func TestOwnsScopesEveryResourceToTheEstablishment(t *testing.T) {
+	staff := Tenant{EstablishmentID: 1}
+	tests := map[Resource]string{
+		EstablishmentResource:     "establishments.id = 1",
+		ClientResource:            `FROM "establishment_clients" WHERE establishment_id = 1`,
+		CreditAccountResource:     "credit_accounts.establishment_id = 1",
+		CreditRequestResource:     "credit_requests.establishment_id = 1",
+		TransactionResource:       "credit_accounts.establishment_id = 1",
+		InstallmentResource:       "credit_accounts.establishment_id = 1",
+		LateFeeResource:           "credit_accounts.establishment_id = 1",
+		LateFeeRuleResource:       "late_fee_rules.establishment_id = 1",
+		ProductResource:           "products.establishment_id = 1",
+		CreditLimitChangeResource: "credit_accounts.establishment_id = 1",
+		ApplicantResource:         `FROM "credit_requests" WHERE establishment_id = 1`,
+	}
+	for resource, want := range tests {
+		db, recorder := dryRunDB(t)
+		_ = NewTenantRepository(db).Owns(staff, resource, 2)
+		requireEvery(t, recorder, want)
+	}
+}
+
+func TestListsOnlyReturnTheEstablishmentRecords(t *testing.T) {
+	staff := Tenant{EstablishmentID: 1}
+
+	db, recorder := dryRunDB(t)
+	_, _ = NewProductRepository(db).GetAll(request.ListQuery{}, staff)
+	requireEvery(t, recorder, "products.establishment_id = 1")
+
+	db, recorder = dryRunDB(t)
+	_, _ = NewLateFeeRuleRepository(db).GetAll(request.ListQuery{}, staff)
+	requireEvery(t, recorder, "late_fee_rules.establishment_id = 1")
+
+	db, recorder = dryRunDB(t)
+	_, _ = NewClientRepository(db).GetAllClients(request.ListQuery{}, staff)
+	requireEvery(t, recorder, `FROM "establishment_clients" WHERE establishment_id = 1`)
+
+	db, recorder = dryRunDB(t)
+	_, _ = NewCreditAccountRepository(db).GetByClientID(5, request.ListQuery{}, staff)
+	requireEvery(t, recorder, "credit_accounts.establishment_id = 1")
+
+	db, recorder = dryRunDB(t)
+	_, _ = NewAuditLogRepository(db).List(request.AuditLogQuery{}, staff)
+	requireEvery(t, recorder, "establishment_id = 1")
+}
+
+func TestClientsOnlySeeTheirOwnRecords(t *testing.T) {
+	client := Tenant{ClientUserID: 7}
+	tests := map[Resource]string{
+		ClientResource:        "clients.user_id = 7",
+		CreditAccountResource: "user_id = 7",
+		TransactionResource:   "user_id = 7",
+		LateFeeRuleResource:   "FALSE",
+	}
+	for resource, want := range tests {
+		db, recorder := dryRunDB(t)
+		_ = NewTenantRepository(db).Owns(client, resource, 2)
+		requireEvery(t, recorder, want)
+	}
+}
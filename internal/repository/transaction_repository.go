@@ -1,22 +1,58 @@
 package repository
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/ledger"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+	"gorm.io/gorm/clause"
+
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
 	"gorm.io/gorm"
 )
 
+// transactionListSpec describes the list queries on transactions. The status filter matches
+// the transaction type.
+var transactionListSpec = listSpec{
+	table:       "transactions",
+	sorts:       map[string]string{"id": "id", "transaction_date": "transaction_date", "amount": "amount"},
+	defaultSort: "transaction_date",
+	status:      "transaction_type",
+	date:        "transaction_date",
+	amount:      "amount",
+}
+
+// historyListSpec describes the searches on the history of credit accounts. The status filter
+// matches the transaction type; the amount range is applied by SearchHistory, since history
+// amounts are signed.
+var historyListSpec = listSpec{
+	table:       "credit_account_histories",
+	sorts:       map[string]string{"id": "id", "transaction_date": "transaction_date", "amount": "amount"},
+	defaultSort: "transaction_date",
+	status:      "transaction_type",
+	date:        "transaction_date",
+}
+
+// likeEscaper escapes the wildcards of text searched with LIKE.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // TransactionRepository defines the interface for transaction repository operations.
 type TransactionRepository interface {
 	Create(req request.CreateTransactionRequest) (*response.TransactionResponse, error)
 	GetByID(id uint) (*response.TransactionResponse, error)
-	Update(id uint, req request.UpdateTransactionRequest) (*response.TransactionResponse, error)
-	Delete(id uint) error
-	GetByCreditAccountID(creditAccountID uint) ([]response.TransactionResponse, error)
-	GetTransactionsByDateRange(creditAccountID uint, startDate, endDate time.Time) ([]entities.Transaction, error)
+	Reverse(id uint, req request.ReverseTransactionRequest) (*response.TransactionResponse, error)
+	Adjust(creditAccountID uint, req request.CreateAdjustmentRequest) (*response.TransactionResponse, error)
+	WriteOff(creditAccountID uint, req request.WriteOffRequest) (*response.TransactionResponse, error)
+	GetByCreditAccountID(creditAccountID uint, query request.TransactionSearchQuery) (*response.PageResponse[response.TransactionResponse], error)
+	SearchHistory(creditAccountID uint, query request.TransactionSearchQuery) (*response.TransactionHistoryResponse, error)
+	GetAllocations(transactionID uint) ([]response.PaymentAllocationResponse, error)
 }
 
 type transactionRepository struct {
@@ -28,19 +64,71 @@ func NewTransactionRepository(db *gorm.DB) TransactionRepository {
 	return &transactionRepository{db: db}
 }
 
-// Create creates a new transaction.
+// Create posts a transaction on a credit account, updating its balance and writing the history
+// and journal entries. Payments are allocated the same way ProcessPayment does.
 func (r *transactionRepository) Create(req request.CreateTransactionRequest) (*response.TransactionResponse, error) {
-	transaction := entities.Transaction{
-		CreditAccountID: req.CreditAccountID,
-		RecipientType:   req.RecipientType,
-		RecipientID:     req.RecipientID, // Assuming you have the recipient logic in place
-		TransactionType: req.TransactionType,
-		Amount:          req.Amount,
-		Description:     req.Description,
-		TransactionDate: time.Now(),
-	}
+	var transaction entities.Transaction
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the credit account
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, req.CreditAccountID).Error; err != nil {
+			return fmt.Errorf("error retrieving credit account: %w", err)
+		}
+		isPayment := req.TransactionType == enums.Payment || req.TransactionType == enums.EarlyPayment
+		if isPayment && req.Amount > creditAccount.CurrentBalance {
+			return errors.New("payment amount exceeds current balance")
+		}
+
+		// 2. Create the transaction record
+		transaction = entities.Transaction{
+			CreditAccountID: req.CreditAccountID,
+			RecipientType:   req.RecipientType,
+			RecipientID:     req.RecipientID, // Assuming you have the recipient logic in place
+			TransactionType: req.TransactionType,
+			Amount:          req.Amount,
+			Description:     req.Description,
+			TransactionDate: time.Now(),
+		}
+		if err := tx.Create(&transaction).Error; err != nil {
+			return err
+		}
 
-	err := r.db.Create(&transaction).Error
+		// 3. Post the journal entry, which updates the balance
+		balanceBefore := creditAccount.CurrentBalance
+		if isPayment {
+			if err := applyPayment(tx, &creditAccount, &transaction); err != nil {
+				return err
+			}
+		} else {
+			lines, ok := ledger.Lines(req.TransactionType, req.Amount, nil)
+			if !ok {
+				return fmt.Errorf("invalid transaction type: %s", req.TransactionType)
+			}
+			if _, err := postLedger(tx, &creditAccount, &transaction, lines); err != nil {
+				return err
+			}
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account balance: %w", err)
+		}
+
+		// 4. Create a CreditAccountHistory record
+		historyEntry := entities.CreditAccountHistory{
+			CreditAccountID: creditAccount.ID,
+			TransactionID:   &transaction.ID,
+			TransactionDate: transaction.TransactionDate,
+			TransactionType: transaction.TransactionType,
+			Amount:          creditAccount.CurrentBalance - balanceBefore,
+			Balance:         creditAccount.CurrentBalance,
+			Description:     transaction.Description,
+		}
+		if err := tx.Create(&historyEntry).Error; err != nil {
+			return fmt.Errorf("error creating credit account history: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -56,69 +144,452 @@ func (r *transactionRepository) GetByID(id uint) (*response.TransactionResponse,
 		return nil, err
 	}
 
-	return getTransactionResponse(&transaction), nil
+	transactionResponse := getTransactionResponse(&transaction)
+	lines, err := purchaseLinesByTransaction(r.db, []uint{transaction.ID})
+	if err != nil {
+		return nil, err
+	}
+	transactionResponse.Lines = lines[transaction.ID]
+	return transactionResponse, nil
 }
 
-// Update updates an existing transaction.
-func (r *transactionRepository) Update(id uint, req request.UpdateTransactionRequest) (*response.TransactionResponse, error) {
-	var transaction entities.Transaction
-	err := r.db.First(&transaction, id).Error
+// Reverse posts a REVERSAL that cancels the balance effect of a transaction and writes the matching
+// history entry. Payments also give back what they had allocated to components and installments,
+// and itemized purchases return their units to stock.
+func (r *transactionRepository) Reverse(id uint, req request.ReverseTransactionRequest) (*response.TransactionResponse, error) {
+	var reversal entities.Transaction
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve the original transaction and make sure it can be reversed
+		var original entities.Transaction
+		if err := tx.First(&original, id).Error; err != nil {
+			return err
+		}
+		if original.TransactionType == enums.Reversal {
+			return ErrNotReversible
+		}
+		var reversals int64
+		if err := tx.Model(&entities.Transaction{}).Where("reversal_of_id = ?", id).Count(&reversals).Error; err != nil {
+			return fmt.Errorf("error checking previous reversals: %w", err)
+		}
+		if reversals > 0 {
+			return ErrAlreadyReversed
+		}
+
+		// 2. Retrieve and lock the credit account
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, original.CreditAccountID).Error; err != nil {
+			return fmt.Errorf("error retrieving credit account: %w", err)
+		}
+
+		// 3. Create the reversal transaction
+		now := time.Now()
+		description := req.Description
+		if description == "" {
+			description = fmt.Sprintf("Reversal of transaction #%d", original.ID)
+		}
+		reversal = entities.Transaction{
+			CreditAccountID: original.CreditAccountID,
+			RecipientType:   original.RecipientType,
+			RecipientID:     original.RecipientID,
+			TransactionType: enums.Reversal,
+			Amount:          original.Amount,
+			Description:     description,
+			TransactionDate: now,
+			ReversalOfID:    &original.ID,
+			ReasonCode:      req.ReasonCode,
+		}
+		if err := tx.Create(&reversal).Error; err != nil {
+			return fmt.Errorf("error creating reversal transaction: %w", err)
+		}
+
+		// 4. Post the mirror of the original journal entry, which undoes the effect on the balance
+		// and its components. Transactions posted before the ledger are mirrored from their type.
+		lines, posted, err := journalLinesOf(tx, original.ID)
+		if err != nil {
+			return err
+		}
+		if !posted {
+			var ok bool
+			if lines, ok = ledger.Lines(original.TransactionType, original.Amount, finance.Balances{enums.PrincipalComponent: original.Amount}); !ok {
+				return ErrNotReversible
+			}
+		}
+		change, err := postLedger(tx, &creditAccount, &reversal, ledger.Mirror(lines))
+		if err != nil {
+			return err
+		}
+		if original.TransactionType == enums.Payment || original.TransactionType == enums.EarlyPayment {
+			if err := revertPaymentAllocations(tx, original.ID, reversal.ID, now); err != nil {
+				return err
+			}
+		}
+		if original.TransactionType == enums.Purchase {
+			if err := restockPurchaseLines(tx, original.ID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account balance: %w", err)
+		}
+
+		// 5. Create a CreditAccountHistory record
+		historyEntry := entities.CreditAccountHistory{
+			CreditAccountID: creditAccount.ID,
+			TransactionID:   &reversal.ID,
+			TransactionDate: now,
+			TransactionType: enums.Reversal,
+			Amount:          change,
+			Balance:         creditAccount.CurrentBalance,
+			Description:     description,
+		}
+		if err := tx.Create(&historyEntry).Error; err != nil {
+			return fmt.Errorf("error creating credit account history: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Only update fields if they are provided in the request
-	if req.Amount > 0 {
-		transaction.Amount = req.Amount
-	}
-	if req.Description != "" {
-		transaction.Description = req.Description
-	}
+	return getTransactionResponse(&reversal), nil
+}
+
+// Adjust posts a manual ADJUSTMENT of the balance of a credit account with its history entry.
+func (r *transactionRepository) Adjust(creditAccountID uint, req request.CreateAdjustmentRequest) (*response.TransactionResponse, error) {
+	var adjustment entities.Transaction
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the credit account
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return err
+		}
+
+		// 2. Create the adjustment transaction
+		now := time.Now()
+		adjustment = entities.Transaction{
+			CreditAccountID: creditAccountID,
+			RecipientType:   enums.RolClient,
+			RecipientID:     creditAccount.ClientID,
+			TransactionType: enums.Adjustment,
+			Amount:          req.Amount,
+			Description:     req.Description,
+			TransactionDate: now,
+			ReasonCode:      req.ReasonCode,
+		}
+		if err := tx.Create(&adjustment).Error; err != nil {
+			return fmt.Errorf("error creating adjustment transaction: %w", err)
+		}
+
+		// 3. Post the journal entry and update the credit account balance
+		lines, _ := ledger.Lines(enums.Adjustment, req.Amount, nil)
+		if _, err := postLedger(tx, &creditAccount, &adjustment, lines); err != nil {
+			return err
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account balance: %w", err)
+		}
+
+		// 4. Create a CreditAccountHistory record
+		historyEntry := entities.CreditAccountHistory{
+			CreditAccountID: creditAccountID,
+			TransactionID:   &adjustment.ID,
+			TransactionDate: now,
+			TransactionType: enums.Adjustment,
+			Amount:          req.Amount,
+			Balance:         creditAccount.CurrentBalance,
+			Description:     req.Description,
+		}
+		if err := tx.Create(&historyEntry).Error; err != nil {
+			return fmt.Errorf("error creating credit account history: %w", err)
+		}
 
-	err = r.db.Save(&transaction).Error
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return getTransactionResponse(&transaction), nil
+	return getTransactionResponse(&adjustment), nil
 }
 
-// Delete deletes a transaction.
-func (r *transactionRepository) Delete(id uint) error {
-	var transaction entities.Transaction
-	err := r.db.First(&transaction, id).Error
+// WriteOff charges the whole outstanding balance of a credit account to bad debt and blocks the account.
+func (r *transactionRepository) WriteOff(creditAccountID uint, req request.WriteOffRequest) (*response.TransactionResponse, error) {
+	var writeOff entities.Transaction
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Retrieve and lock the credit account
+		var creditAccount entities.CreditAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&creditAccount, creditAccountID).Error; err != nil {
+			return err
+		}
+
+		// 2. Work out what is owed per component
+		allocation := finance.Balances{}
+		var amount money.Money
+		for component, balance := range finance.BalancesOf(creditAccount) {
+			if balance.IsPositive() {
+				allocation[component] = balance
+				amount += balance
+			}
+		}
+		if !amount.IsPositive() {
+			return ErrNothingToWriteOff
+		}
+
+		// 3. Create the write-off transaction
+		now := time.Now()
+		writeOff = entities.Transaction{
+			CreditAccountID: creditAccountID,
+			RecipientType:   enums.RolClient,
+			RecipientID:     creditAccount.ClientID,
+			TransactionType: enums.WriteOff,
+			Amount:          amount,
+			Description:     req.Description,
+			TransactionDate: now,
+			ReasonCode:      req.ReasonCode,
+		}
+		if err := tx.Create(&writeOff).Error; err != nil {
+			return fmt.Errorf("error creating write-off transaction: %w", err)
+		}
+
+		// 4. Post the journal entry
+		lines, _ := ledger.Lines(enums.WriteOff, amount, allocation)
+		change, err := postLedger(tx, &creditAccount, &writeOff, lines)
+		if err != nil {
+			return err
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error updating credit account balance: %w", err)
+		}
+
+		// 5. Create a CreditAccountHistory record
+		historyEntry := entities.CreditAccountHistory{
+			CreditAccountID: creditAccountID,
+			TransactionID:   &writeOff.ID,
+			TransactionDate: now,
+			TransactionType: enums.WriteOff,
+			Amount:          change,
+			Balance:         creditAccount.CurrentBalance,
+			Description:     req.Description,
+		}
+		if err := tx.Create(&historyEntry).Error; err != nil {
+			return fmt.Errorf("error creating credit account history: %w", err)
+		}
+
+		// 6. Block the credit account
+		if err := applyBlock(tx, &creditAccount, true, "Written off"); err != nil {
+			return err
+		}
+		if err := tx.Save(&creditAccount).Error; err != nil {
+			return fmt.Errorf("error blocking credit account: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return r.db.Delete(&transaction).Error
+	return getTransactionResponse(&writeOff), nil
 }
 
-// GetByCreditAccountID retrieves all transactions for a credit account.
-func (r *transactionRepository) GetByCreditAccountID(creditAccountID uint) ([]response.TransactionResponse, error) {
-	var transactions []entities.Transaction
-	err := r.db.Where("credit_account_id = ?", creditAccountID).Find(&transactions).Error
+// GetByCreditAccountID retrieves a page of the transactions of a credit account.
+func (r *transactionRepository) GetByCreditAccountID(creditAccountID uint, query request.TransactionSearchQuery) (*response.PageResponse[response.TransactionResponse], error) {
+	db := r.db.Where("credit_account_id = ?", creditAccountID).Scopes(matchDescription(transactionListSpec.column("description"), query.Description))
+	page, err := paginate[entities.Transaction](db, query.ListQuery, transactionListSpec)
 	if err != nil {
 		return nil, err
 	}
+	return mapPage(page, getTransactionResponse), nil
+}
 
-	var transactionResponses []response.TransactionResponse
-	for _, transaction := range transactions {
-		transactionResponses = append(transactionResponses, *getTransactionResponse(&transaction))
+// SearchHistory retrieves a page of the history of a credit account, with the running balance
+// after each entry. Amounts are compared by magnitude, so payments match positive ranges too.
+// The totals cover every entry matching the filters, and the opening and closing balances the
+// whole period searched.
+func (r *transactionRepository) SearchHistory(creditAccountID uint, query request.TransactionSearchQuery) (*response.TransactionHistoryResponse, error) {
+	amount := "ABS(" + historyListSpec.column("amount") + ")"
+	matching := func(db *gorm.DB) *gorm.DB {
+		db = db.Where(historyListSpec.column("credit_account_id")+" = ?", creditAccountID).
+			Scopes(matchDescription(historyListSpec.column("description"), query.Description))
+		if query.MinAmount != nil {
+			db = db.Where(amount+" >= ?", *query.MinAmount)
+		}
+		if query.MaxAmount != nil {
+			db = db.Where(amount+" <= ?", *query.MaxAmount)
+		}
+		return db
 	}
 
-	return transactionResponses, nil
+	page, err := paginate[entities.CreditAccountHistory](r.db.Scopes(matching), query.ListQuery, historyListSpec)
+	if err != nil {
+		return nil, err
+	}
+	history := &response.TransactionHistoryResponse{
+		Items:      make([]response.CreditAccountHistoryResponse, 0, len(page.Items)),
+		Total:      page.Total,
+		Limit:      page.Limit,
+		Offset:     page.Offset,
+		NextCursor: page.NextCursor,
+	}
+	var transactionIDs []uint
+	for i := range page.Items {
+		history.Items = append(history.Items, *getCreditAccountHistoryResponse(&page.Items[i]))
+		if page.Items[i].TransactionType == enums.Purchase && page.Items[i].TransactionID != nil {
+			transactionIDs = append(transactionIDs, *page.Items[i].TransactionID)
+		}
+	}
+	if len(transactionIDs) > 0 {
+		lines, err := purchaseLinesByTransaction(r.db, transactionIDs)
+		if err != nil {
+			return nil, err
+		}
+		for i := range history.Items {
+			if id := history.Items[i].TransactionID; id != nil && history.Items[i].TransactionType == enums.Purchase {
+				history.Items[i].Lines = lines[*id]
+			}
+		}
+	}
+
+	if err := r.db.Model(&entities.CreditAccountHistory{}).
+		Scopes(matching, historyListSpec.filters(query.ListQuery)).
+		Select("transaction_type, COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount").
+		Group("transaction_type").Order("transaction_type").
+		Scan(&history.Totals).Error; err != nil {
+		return nil, fmt.Errorf("error summing credit account history: %w", err)
+	}
+	if history.Totals == nil {
+		history.Totals = []response.TransactionTypeTotalResponse{}
+	}
+
+	if query.From != nil {
+		if history.OpeningBalance, err = r.historyBalanceBefore(creditAccountID, query.From); err != nil {
+			return nil, err
+		}
+	}
+	var end *time.Time
+	if query.To != nil {
+		next := query.To.AddDate(0, 0, 1)
+		end = &next
+	}
+	if history.ClosingBalance, err = r.historyBalanceBefore(creditAccountID, end); err != nil {
+		return nil, err
+	}
+
+	return history, nil
 }
 
-// GetTransactionsByDateRange retrieves transactions for a credit account within a date range.
-func (r *transactionRepository) GetTransactionsByDateRange(creditAccountID uint, startDate, endDate time.Time) ([]entities.Transaction, error) {
-	var transactions []entities.Transaction
-	err := r.db.Where("credit_account_id = ? AND transaction_date BETWEEN ? AND ?", creditAccountID, startDate, endDate).Find(&transactions).Error
+// historyBalanceBefore returns the balance of a credit account right before date, taken from
+// its last history entry. A nil date returns the balance after the last entry.
+func (r *transactionRepository) historyBalanceBefore(creditAccountID uint, date *time.Time) (money.Money, error) {
+	var last entities.CreditAccountHistory
+	db := r.db.Where("credit_account_id = ?", creditAccountID)
+	if date != nil {
+		db = db.Where("transaction_date < ?", *date)
+	}
+	err := db.Order("transaction_date DESC, id DESC").First(&last).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return money.Zero, nil
+	}
 	if err != nil {
+		return money.Zero, fmt.Errorf("error retrieving credit account balance: %w", err)
+	}
+
+	return last.Balance, nil
+}
+
+// GetAllocations retrieves how a payment was split across components and installments.
+func (r *transactionRepository) GetAllocations(transactionID uint) ([]response.PaymentAllocationResponse, error) {
+	var transaction entities.Transaction
+	if err := r.db.First(&transaction, transactionID).Error; err != nil {
+		return nil, err
+	}
+
+	var allocations []entities.PaymentAllocation
+	if err := r.db.Where("transaction_id = ?", transactionID).Order("id").Find(&allocations).Error; err != nil {
 		return nil, err
 	}
 
-	return transactions, nil
+	allocationResponses := make([]response.PaymentAllocationResponse, 0, len(allocations))
+	for _, allocation := range allocations {
+		allocationResponses = append(allocationResponses, response.PaymentAllocationResponse{
+			ID:              allocation.ID,
+			TransactionID:   allocation.TransactionID,
+			CreditAccountID: allocation.CreditAccountID,
+			InstallmentID:   allocation.InstallmentID,
+			Component:       allocation.Component,
+			Amount:          allocation.Amount,
+		})
+	}
+
+	return allocationResponses, nil
+}
+
+var (
+	// ErrNotReversible is returned for reversals and for transactions that did not move the balance.
+	ErrNotReversible = errors.New("transaction cannot be reversed")
+	// ErrAlreadyReversed is returned when a transaction already has a reversal.
+	ErrAlreadyReversed = errors.New("transaction has already been reversed")
+	// ErrNothingToWriteOff is returned when a write-off is requested for an account that owes nothing.
+	ErrNothingToWriteOff = errors.New("credit account has no outstanding balance to write off")
+)
+
+// revertPaymentAllocations gives back to the installments what a payment had allocated,
+// recording the reversed parts as negative allocations of the reversal.
+func revertPaymentAllocations(tx *gorm.DB, paymentID, reversalID uint, asOf time.Time) error {
+	var allocations []entities.PaymentAllocation
+	if err := tx.Where("transaction_id = ?", paymentID).Find(&allocations).Error; err != nil {
+		return fmt.Errorf("error retrieving payment allocations: %w", err)
+	}
+
+	reversed := make([]entities.PaymentAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		if allocation.InstallmentID != nil {
+			var installment entities.Installment
+			if err := tx.First(&installment, *allocation.InstallmentID).Error; err != nil {
+				return fmt.Errorf("error retrieving installment: %w", err)
+			}
+			var interest, principal money.Money
+			if allocation.Component == enums.InterestComponent {
+				interest = allocation.Amount
+			} else {
+				principal = allocation.Amount
+			}
+			finance.RevertInstallmentPayment(&installment, interest, principal, asOf)
+			if err := tx.Save(&installment).Error; err != nil {
+				return fmt.Errorf("error updating installment: %w", err)
+			}
+		}
+
+		reversed = append(reversed, entities.PaymentAllocation{
+			TransactionID:   reversalID,
+			CreditAccountID: allocation.CreditAccountID,
+			InstallmentID:   allocation.InstallmentID,
+			Component:       allocation.Component,
+			Amount:          -allocation.Amount,
+		})
+	}
+
+	if len(reversed) > 0 {
+		if err := tx.Create(&reversed).Error; err != nil {
+			return fmt.Errorf("error creating reversed payment allocations: %w", err)
+		}
+	}
+	return nil
+}
+
+// matchDescription restricts a query to the rows whose description column contains text,
+// ignoring case. An empty text matches every row.
+func matchDescription(column, text string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if text == "" {
+			return db
+		}
+		return db.Where(column+" ILIKE ?", "%"+likeEscaper.Replace(text)+"%")
+	}
 }
 
 func getTransactionResponse(transaction *entities.Transaction) *response.TransactionResponse {
@@ -128,7 +599,68 @@ func getTransactionResponse(transaction *entities.Transaction) *response.Transac
 		TransactionType: transaction.TransactionType,
 		Amount:          transaction.Amount,
 		Description:     transaction.Description,
+		TransactionDate: transaction.TransactionDate,
+		ReversalOfID:    transaction.ReversalOfID,
+		ReasonCode:      transaction.ReasonCode,
 		CreatedAt:       transaction.CreatedAt,
 		UpdatedAt:       transaction.UpdatedAt,
 	}
 }
+
+// purchaseLinesByTransaction returns the lines of the itemized purchases among the given
+// transactions, keyed by transaction.
+func purchaseLinesByTransaction(db *gorm.DB, transactionIDs []uint) (map[uint][]response.PurchaseLineResponse, error) {
+	var purchaseLines []entities.PurchaseLine
+	if err := db.Where("transaction_id IN ?", transactionIDs).Order("id").Find(&purchaseLines).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving purchase lines: %w", err)
+	}
+
+	lines := make(map[uint][]response.PurchaseLineResponse)
+	for i := range purchaseLines {
+		lines[purchaseLines[i].TransactionID] = append(lines[purchaseLines[i].TransactionID], *getPurchaseLineResponse(&purchaseLines[i]))
+	}
+	return lines, nil
+}
+
+// restockPurchaseLines puts the units sold by an itemized purchase back in stock.
+func restockPurchaseLines(tx *gorm.DB, transactionID uint) error {
+	var purchaseLines []entities.PurchaseLine
+	if err := tx.Where("transaction_id = ?", transactionID).Find(&purchaseLines).Error; err != nil {
+		return fmt.Errorf("error retrieving purchase lines: %w", err)
+	}
+	for _, line := range purchaseLines {
+		if err := tx.Model(&entities.Product{}).Where("id = ?", line.ProductID).
+			Update("stock", gorm.Expr("stock + ?", line.Quantity)).Error; err != nil {
+			return fmt.Errorf("error restocking product %d: %w", line.ProductID, err)
+		}
+	}
+	return nil
+}
+
+func getPurchaseLineResponse(line *entities.PurchaseLine) *response.PurchaseLineResponse {
+	return &response.PurchaseLineResponse{
+		ID:              line.ID,
+		TransactionID:   line.TransactionID,
+		ProductID:       line.ProductID,
+		ProductName:     line.ProductName,
+		Quantity:        line.Quantity,
+		UnitPrice:       line.UnitPrice,
+		Amount:          line.Amount,
+		PriceOverridden: line.PriceOverridden,
+	}
+}
+
+func getCreditAccountHistoryResponse(entry *entities.CreditAccountHistory) *response.CreditAccountHistoryResponse {
+	return &response.CreditAccountHistoryResponse{
+		ID:              entry.ID,
+		CreditAccountID: entry.CreditAccountID,
+		TransactionID:   entry.TransactionID,
+		TransactionDate: entry.TransactionDate,
+		TransactionType: entry.TransactionType,
+		Amount:          entry.Amount,
+		Balance:         entry.Balance,
+		Description:     entry.Description,
+		OldLimit:        entry.OldLimit,
+		NewLimit:        entry.NewLimit,
+	}
+}
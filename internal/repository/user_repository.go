@@ -26,7 +26,7 @@ func (r *userRepository) CreateUser(user *entities.User) error {
 
 func (r *userRepository) GetUserByEmail(email string) (*entities.User, error) {
 	var user entities.User
-	err := r.db.Preload("Roles").Where("email = ?", email).First(&user).Error
+	err := r.db.Preload("Roles").Preload("Admin").Preload("Client").Where("email = ?", email).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +35,7 @@ func (r *userRepository) GetUserByEmail(email string) (*entities.User, error) {
 
 func (r *userRepository) GetUserByID(userID uint) (*entities.User, error) {
 	var user entities.User
-	err := r.db.Preload("Roles").First(&user, userID).Error
+	err := r.db.Preload("Roles").Preload("Admin").Preload("Client").First(&user, userID).Error
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,286 @@
+// Package scheduler runs the periodic batches (interest accrual, late fees, credit limit reviews, delinquency blocks) inside the API process.
+//
+// Every replica schedules the same jobs, but a run only proceeds on the replica that
+// takes the job's Postgres advisory lock, and a scheduled run is skipped when another
+// replica already ran it for the same tick.
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"sync"
+	"time"
+
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/repository"
+	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
+)
+
+// Job names.
+const (
+	ApplyInterestJob = "apply-interest"
+	ApplyLateFeesJob = "apply-late-fees"
+	ReviewLimitsJob  = "review-credit-limits"
+	DelinquencyJob   = "apply-delinquency-policy"
+)
+
+// ErrJobNotFound is returned for a job name that is not registered.
+var ErrJobNotFound = errors.New("scheduled job not found")
+
+// ErrJobRunning is returned when the job is already running, here or on another replica.
+var ErrJobRunning = errors.New("job is already running")
+
+// JobFunc runs a job for one establishment.
+type JobFunc func(establishmentID uint) error
+
+type registeredJob struct {
+	fn      JobFunc
+	entryID cron.EntryID
+}
+
+// Scheduler runs registered jobs on their cron schedule.
+type Scheduler struct {
+	db                *gorm.DB
+	jobRepo           repository.ScheduledJobRepository
+	establishmentRepo repository.EstablishmentRepository
+	cron              *cron.Cron
+
+	mu   sync.RWMutex
+	jobs map[string]registeredJob
+}
+
+// New creates a scheduler. Jobs are added with Register and start running after Start.
+func New(db *gorm.DB, jobRepo repository.ScheduledJobRepository, establishmentRepo repository.EstablishmentRepository) *Scheduler {
+	return &Scheduler{
+		db:                db,
+		jobRepo:           jobRepo,
+		establishmentRepo: establishmentRepo,
+		cron:              cron.New(cron.WithChain(cron.Recover(cron.PrintfLogger(log.Default())))),
+		jobs:              make(map[string]registeredJob),
+	}
+}
+
+// Register persists a job and schedules it with a standard 5-field cron expression.
+func (s *Scheduler) Register(name, description, spec string, fn JobFunc) error {
+	if _, err := cron.ParseStandard(spec); err != nil {
+		return fmt.Errorf("invalid cron expression for job %s: %w", name, err)
+	}
+	if _, err := s.jobRepo.Register(name, description, spec); err != nil {
+		return fmt.Errorf("error registering job %s: %w", name, err)
+	}
+
+	entryID, err := s.cron.AddFunc(spec, func() {
+		tick := time.Now().Truncate(time.Minute)
+		if _, err := s.run(name, enums.ScheduledTrigger, tick); err != nil && !errors.Is(err, ErrJobRunning) {
+			log.Printf("scheduler: job %s failed: %v", name, err)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("error scheduling job %s: %w", name, err)
+	}
+
+	s.mu.Lock()
+	s.jobs[name] = registeredJob{fn: fn, entryID: entryID}
+	s.mu.Unlock()
+	return nil
+}
+
+// Start starts running jobs in the background.
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop stops scheduling new runs and waits for running ones to finish.
+func (s *Scheduler) Stop() {
+	<-s.cron.Stop().Done()
+}
+
+// Jobs lists the registered jobs with their next run time.
+func (s *Scheduler) Jobs() ([]response.ScheduledJobResponse, error) {
+	jobs, err := s.jobRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	jobResponses := []response.ScheduledJobResponse{}
+	for _, job := range jobs {
+		if _, ok := s.job(job.Name); !ok {
+			continue // Job registered by another version of the API
+		}
+		jobResponses = append(jobResponses, *s.jobResponse(&job))
+	}
+
+	return jobResponses, nil
+}
+
+// SetPaused pauses or resumes the scheduled runs of a job on every replica.
+func (s *Scheduler) SetPaused(name string, paused bool) (*response.ScheduledJobResponse, error) {
+	if _, ok := s.job(name); !ok {
+		return nil, ErrJobNotFound
+	}
+
+	job, err := s.jobRepo.SetPaused(name, paused)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.jobResponse(job), nil
+}
+
+// Trigger runs a job right away, even when it is paused, and returns its runs.
+func (s *Scheduler) Trigger(name string) ([]response.JobRunResponse, error) {
+	return s.run(name, enums.ManualTrigger, time.Now())
+}
+
+// Runs returns the latest runs of a job.
+func (s *Scheduler) Runs(name string, limit int) ([]response.JobRunResponse, error) {
+	if _, ok := s.job(name); !ok {
+		return nil, ErrJobNotFound
+	}
+
+	job, err := s.jobRepo.GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := s.jobRepo.GetRuns(job.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	runResponses := []response.JobRunResponse{}
+	for _, run := range runs {
+		runResponses = append(runResponses, *jobRunResponse(&run))
+	}
+
+	return runResponses, nil
+}
+
+// run executes a job for every establishment while holding the job's advisory lock.
+func (s *Scheduler) run(name string, trigger enums.JobTrigger, tick time.Time) ([]response.JobRunResponse, error) {
+	registered, ok := s.job(name)
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+
+	runResponses := []response.JobRunResponse{}
+	err := s.withLock(name, func() error {
+		// 1. Reload the job, another replica may have paused or already run it
+		job, err := s.jobRepo.GetByName(name)
+		if err != nil {
+			return fmt.Errorf("error retrieving job: %w", err)
+		}
+		if trigger == enums.ScheduledTrigger {
+			if job.IsPaused {
+				return nil
+			}
+			if job.LastRunAt != nil && !job.LastRunAt.Before(tick) {
+				return nil // Already run for this tick by another replica
+			}
+		}
+
+		// 2. Run the job for every establishment, recording each outcome
+		startedAt := time.Now()
+		establishments, err := s.establishmentRepo.GetAll()
+		if err != nil {
+			return fmt.Errorf("error retrieving establishments: %w", err)
+		}
+		for _, establishment := range establishments {
+			run, err := s.jobRepo.StartRun(job.ID, establishment.ID, trigger)
+			if err != nil {
+				return fmt.Errorf("error recording job run: %w", err)
+			}
+			if err := s.jobRepo.FinishRun(run, runFor(registered.fn, establishment.ID)); err != nil {
+				return fmt.Errorf("error recording job outcome: %w", err)
+			}
+			runResponses = append(runResponses, *jobRunResponse(run))
+		}
+
+		// 3. Remember the run so other replicas skip this tick
+		return s.jobRepo.MarkRun(job.ID, startedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return runResponses, nil
+}
+
+// runFor runs a job for one establishment, turning a panic into an error so the run is
+// recorded as failed and the other establishments still run.
+func runFor(fn JobFunc, establishmentID uint) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+	return fn(establishmentID)
+}
+
+// withLock runs fn while holding a session-level advisory lock for the job. The lock is
+// taken and released on the same pooled connection.
+func (s *Scheduler) withLock(name string, fn func() error) error {
+	key := lockKey(name)
+	return s.db.Connection(func(conn *gorm.DB) error {
+		var locked bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?)", key).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("error acquiring job lock: %w", err)
+		}
+		if !locked {
+			return ErrJobRunning
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", key)
+
+		return fn()
+	})
+}
+
+func (s *Scheduler) job(name string) (registeredJob, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[name]
+	return job, ok
+}
+
+func (s *Scheduler) jobResponse(job *entities.ScheduledJob) *response.ScheduledJobResponse {
+	jobResponse := &response.ScheduledJobResponse{
+		ID:             job.ID,
+		Name:           job.Name,
+		Description:    job.Description,
+		CronExpression: job.CronExpression,
+		IsPaused:       job.IsPaused,
+		LastRunAt:      job.LastRunAt,
+	}
+	if registered, ok := s.job(job.Name); ok && !job.IsPaused {
+		if next := s.cron.Entry(registered.entryID).Next; !next.IsZero() {
+			jobResponse.NextRunAt = &next
+		}
+	}
+
+	return jobResponse
+}
+
+func jobRunResponse(run *entities.JobRun) *response.JobRunResponse {
+	return &response.JobRunResponse{
+		ID:              run.ID,
+		ScheduledJobID:  run.ScheduledJobID,
+		EstablishmentID: run.EstablishmentID,
+		Trigger:         run.Trigger,
+		Status:          run.Status,
+		StartedAt:       run.StartedAt,
+		FinishedAt:      run.FinishedAt,
+		Error:           run.Error,
+	}
+}
+
+// lockKey maps a job name to the 64-bit key of its advisory lock.
+func lockKey(name string) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte("scheduler:" + name))
+	return int64(hash.Sum64())
+}
@@ -0,0 +1,22 @@
+package scheduler
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRunForRecordsPanicsAsErrors(t *testing.T) {
+	err := runFor(func(uint) error { panic("division by zero") }, 1)
+	if err == nil || !strings.Contains(err.Error(), "division by zero") {
+		t.Errorf("runFor of a panicking job = %v, want an error with the panic", err)
+	}
+
+	failed := errors.New("failed")
+	if err := runFor(func(uint) error { return failed }, 1); !errors.Is(err, failed) {
+		t.Errorf("runFor of a failing job = %v, want %v", err, failed)
+	}
+	if err := runFor(func(uint) error { return nil }, 1); err != nil {
+		t.Errorf("runFor of a successful job = %v, want nil", err)
+	}
+}
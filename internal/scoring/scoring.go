@@ -0,0 +1,158 @@
+// Package scoring rates the credit history of a client and decides credit requests with the
+// policy of an establishment.
+package scoring
+
+import (
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
+)
+
+// MaxScore is the score of a client with a flawless history.
+const MaxScore = 1000
+
+// Points each factor contributes to the score, adding up to MaxScore.
+const (
+	punctualityPoints = 350
+	overduePoints     = 250
+	utilizationPoints = 250
+	agePoints         = 150
+)
+
+const (
+	pointsPerOverdue   = 125 // Lost for every overdue installment
+	healthyUtilization = 0.3 // Utilization up to which no points are lost
+	matureAccountAge   = 24  // Months after which an account earns every age point
+	reviewUtilization  = 0.5 // Utilization from which a well rated account is proposed a higher limit
+)
+
+// Factors is the credit history of a client across every establishment.
+type Factors struct {
+	Payments            int         // Payments posted on the client's accounts
+	LateFees            int         // Late fees applied to the client's accounts
+	OverdueInstallments int         // Installments past their due date and not fully paid
+	Balance             money.Money // Outstanding balance of the client's accounts
+	CreditLimit         money.Money // Credit limit of the client's accounts
+	OldestAccount       *time.Time  // Opening of the oldest account, nil when the client has none
+}
+
+// Result is the score of a client with the reasons behind it.
+type Result struct {
+	Score   int
+	Reasons []string
+}
+
+// Score rates the history of a client from 0 to MaxScore. Clients without history get half
+// of the punctuality and utilization points and none of the age points.
+func Score(f Factors, asOf time.Time) Result {
+	var result Result
+	add := func(points int, reason string, args ...interface{}) {
+		result.Score += points
+		result.Reasons = append(result.Reasons, fmt.Sprintf(reason, args...))
+	}
+
+	// Payment punctuality: the share of payments that were not followed by late fees
+	if events := f.Payments + f.LateFees; events == 0 {
+		add(punctualityPoints/2, "no payment history")
+	} else {
+		add(punctualityPoints*f.Payments/events, "%d payments and %d late fees", f.Payments, f.LateFees)
+	}
+
+	// Overdue installments
+	if f.OverdueInstallments == 0 {
+		add(overduePoints, "no overdue installments")
+	} else {
+		add(max(0, overduePoints-pointsPerOverdue*f.OverdueInstallments), "%d overdue installments", f.OverdueInstallments)
+	}
+
+	// Utilization of the credit limit
+	if !f.CreditLimit.IsPositive() {
+		add(utilizationPoints/2, "no credit limit in use")
+	} else {
+		utilization := f.Balance.Float64() / f.CreditLimit.Float64()
+		points := utilizationPoints
+		if utilization > healthyUtilization {
+			points = int(float64(utilizationPoints) * (1 - utilization) / (1 - healthyUtilization))
+		}
+		add(max(0, points), "%.0f%% of the credit limit in use", utilization*100)
+	}
+
+	// Age of the oldest account
+	if f.OldestAccount == nil {
+		add(0, "no credit accounts")
+	} else {
+		months := monthsBetween(*f.OldestAccount, asOf)
+		add(agePoints*min(months, matureAccountAge)/matureAccountAge, "oldest account opened %d months ago", months)
+	}
+
+	return result
+}
+
+// Decide applies the policy of an establishment to a scored request. Without an enabled
+// policy every request is left for manual review, and so are the requests asking for a larger
+// limit, a lower rate, a longer grace period or a longer term than the policy approves.
+func Decide(result Result, policy *entities.CreditPolicy, creditRequest entities.CreditRequest) (enums.CreditDecision, string) {
+	rate := finance.InterestTerms{
+		AnnualRate:   creditRequest.InterestRate,
+		InterestType: creditRequest.InterestType,
+		DayCount:     creditRequest.DayCount,
+		Compounding:  creditRequest.Compounding,
+	}.EffectiveAnnualRate() * 100
+
+	switch {
+	case policy == nil || !policy.Enabled:
+		return enums.ManualReview, "no automatic decision policy"
+	case result.Score < policy.AutoRejectBelowScore:
+		return enums.AutoRejected, fmt.Sprintf("score %d is below %d", result.Score, policy.AutoRejectBelowScore)
+	case result.Score < policy.AutoApproveMinScore:
+		return enums.ManualReview, fmt.Sprintf("score %d is below %d", result.Score, policy.AutoApproveMinScore)
+	case policy.MaxAutoApproveLimit.IsPositive() && creditRequest.RequestedCreditLimit > policy.MaxAutoApproveLimit:
+		return enums.ManualReview, fmt.Sprintf("requested limit exceeds %s", policy.MaxAutoApproveLimit)
+	case rate < policy.MinInterestRate:
+		return enums.ManualReview, fmt.Sprintf("requested TEA %.2f%% is below %.2f%%", rate, policy.MinInterestRate)
+	case creditRequest.GracePeriod > policy.MaxGracePeriod:
+		return enums.ManualReview, fmt.Sprintf("requested grace period of %d months exceeds %d", creditRequest.GracePeriod, policy.MaxGracePeriod)
+	case creditRequest.CreditType == enums.LongTerm && creditRequest.Term > policy.MaxTerm:
+		return enums.ManualReview, fmt.Sprintf("requested term of %d months exceeds %d", creditRequest.Term, policy.MaxTerm)
+	default:
+		return enums.AutoApproved, fmt.Sprintf("score %d is at least %d", result.Score, policy.AutoApproveMinScore)
+	}
+}
+
+// ReviewLimit proposes a new credit limit for an account from the score of its client, moving
+// the limit by the review step of the policy. Well rated clients using much of their limit are
+// proposed an increase and poorly rated ones a decrease, never below their balance. ok is
+// false when no change is proposed.
+func ReviewLimit(result Result, policy *entities.CreditPolicy, limit, balance money.Money) (newLimit money.Money, reason string, ok bool) {
+	if policy == nil || !policy.Enabled || policy.LimitReviewPercent <= 0 || !limit.IsPositive() {
+		return money.Zero, "", false
+	}
+	step := limit.Percent(policy.LimitReviewPercent)
+	utilization := balance.Float64() / limit.Float64()
+
+	switch {
+	case result.Score >= policy.AutoApproveMinScore && utilization >= reviewUtilization:
+		return limit + step, fmt.Sprintf("score %d is at least %d with %.0f%% of the limit in use", result.Score, policy.AutoApproveMinScore, utilization*100), true
+	case result.Score < policy.AutoRejectBelowScore:
+		newLimit = (limit - step).Max(balance)
+		if newLimit >= limit {
+			return money.Zero, "", false
+		}
+		return newLimit, fmt.Sprintf("score %d is below %d", result.Score, policy.AutoRejectBelowScore), true
+	default:
+		return money.Zero, "", false
+	}
+}
+
+// monthsBetween returns the number of whole months from one date to another.
+func monthsBetween(from, to time.Time) int {
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	if to.Day() < from.Day() {
+		months--
+	}
+	return max(0, months)
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
 	"errors"
 	"fmt"
 
@@ -11,7 +13,7 @@ import (
 
 type AdminService interface {
 	CreateAdmin(admin *entities.Admin) error
-	GetAllAdmins() ([]entities.Admin, error)
+	GetAllAdmins(query request.ListQuery) (*response.PageResponse[entities.Admin], error)
 	GetAdminByID(adminID uint) (*entities.Admin, error)
 	UpdateAdmin(admin *entities.Admin) error
 	DeleteAdmin(adminID uint) error
@@ -24,10 +26,11 @@ type adminService struct {
 	adminRepo         repository.AdminRepository
 	establishmentRepo repository.EstablishmentRepository
 	userRepo          repository.UserRepository
+	roleRepo          repository.RoleRepository
 }
 
-func NewAdminService(adminRepo repository.AdminRepository, establishmentRepo repository.EstablishmentRepository, userRepo repository.UserRepository) AdminService {
-	return &adminService{adminRepo: adminRepo, establishmentRepo: establishmentRepo, userRepo: userRepo}
+func NewAdminService(adminRepo repository.AdminRepository, establishmentRepo repository.EstablishmentRepository, userRepo repository.UserRepository, roleRepo repository.RoleRepository) AdminService {
+	return &adminService{adminRepo: adminRepo, establishmentRepo: establishmentRepo, userRepo: userRepo, roleRepo: roleRepo}
 }
 
 func (s *adminService) CreateAdmin(admin *entities.Admin) error {
@@ -35,11 +38,15 @@ func (s *adminService) CreateAdmin(admin *entities.Admin) error {
 	if existingAdmin != nil {
 		return errors.New("exist already an admin with this user id")
 	}
-	return s.adminRepo.CreateAdmin(admin)
+	if err := s.adminRepo.CreateAdmin(admin); err != nil {
+		return err
+	}
+	// Admins manage their establishment
+	return s.roleRepo.GrantRole(admin.UserID, enums.EstablishmentAdminRole)
 }
 
-func (s *adminService) GetAllAdmins() ([]entities.Admin, error) {
-	return s.adminRepo.GetAllAdmins()
+func (s *adminService) GetAllAdmins(query request.ListQuery) (*response.PageResponse[entities.Admin], error) {
+	return s.adminRepo.GetAllAdmins(query)
 }
 
 func (s *adminService) GetAdminByID(adminID uint) (*entities.Admin, error) {
@@ -94,6 +101,11 @@ func (s *adminService) RegisterEstablishment(establishment *entities.Establishme
 		return fmt.Errorf("error al crear el establecimiento: %w", err)
 	}
 
+	// The user who registers the establishment becomes its admin
+	if err := s.roleRepo.GrantRole(admin.ID, enums.EstablishmentAdminRole); err != nil {
+		return fmt.Errorf("error al asignar el rol de administrador: %w", err)
+	}
+
 	return nil
 }
 func (s *adminService) GetEstablishmentByID(establishmentID uint) (*entities.Establishment, error) {
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+)
+
+// AuditService defines the interface for audit log service operations.
+type AuditService interface {
+	GetAuditLogs(query request.AuditLogQuery, tenant repository.Tenant) (*response.PageResponse[response.AuditLogResponse], error)
+	VerifyAuditLog() (*response.AuditVerificationResponse, error)
+}
+
+type auditService struct {
+	auditLogRepo repository.AuditLogRepository
+}
+
+// NewAuditService creates a new instance of AuditService.
+func NewAuditService(auditLogRepo repository.AuditLogRepository) AuditService {
+	return &auditService{auditLogRepo: auditLogRepo}
+}
+
+// GetAuditLogs retrieves a page of the audit log entries visible to a tenant.
+func (s *auditService) GetAuditLogs(query request.AuditLogQuery, tenant repository.Tenant) (*response.PageResponse[response.AuditLogResponse], error) {
+	return s.auditLogRepo.List(query, tenant)
+}
+
+// VerifyAuditLog checks that no audit log entry was changed or removed.
+func (s *auditService) VerifyAuditLog() (*response.AuditVerificationResponse, error) {
+	return s.auditLogRepo.Verify()
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	"errors"
+	"time"
 
+	"ApiRestFinance/internal/auth"
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
 	"ApiRestFinance/internal/repository"
 	"ApiRestFinance/internal/util"
 	"github.com/golang-jwt/jwt/v4"
@@ -16,6 +19,8 @@ type AuthService interface {
 	RegisterUser(req *request.CreateUserRequest) error
 	Login(req *request.LoginRequest) (*response.AuthResponse, error)
 	Refresh(refreshToken string) (*response.AuthResponse, error)
+	Logout(refreshToken string) error
+	LogoutAll(userID uint) error
 	ValidateToken(tokenString string) (jwt.MapClaims, error)
 	ResetPassword(req *request.ResetPasswordRequest, userID uint) error
 }
@@ -23,11 +28,13 @@ type AuthService interface {
 type authService struct {
 	userRepo          repository.UserRepository
 	establishmentRepo repository.EstablishmentRepository
+	roleRepo          repository.RoleRepository
+	refreshTokenRepo  repository.RefreshTokenRepository
 	jwtSecret         string
 }
 
-func NewAuthService(userRepo repository.UserRepository, establishmentRepo repository.EstablishmentRepository, jwtSecret string) AuthService {
-	return &authService{userRepo: userRepo, establishmentRepo: establishmentRepo, jwtSecret: jwtSecret}
+func NewAuthService(userRepo repository.UserRepository, establishmentRepo repository.EstablishmentRepository, roleRepo repository.RoleRepository, refreshTokenRepo repository.RefreshTokenRepository, jwtSecret string) AuthService {
+	return &authService{userRepo: userRepo, establishmentRepo: establishmentRepo, roleRepo: roleRepo, refreshTokenRepo: refreshTokenRepo, jwtSecret: jwtSecret}
 }
 
 func (s *authService) RegisterUser(req *request.CreateUserRequest) error {
@@ -43,12 +50,16 @@ func (s *authService) RegisterUser(req *request.CreateUserRequest) error {
 		return err
 	}
 
-	// Crear usuario con rol "user" por defecto
+	// Crear usuario con rol "client" por defecto
+	roles, err := s.roleRepo.GetByNames([]enums.RoleName{enums.ClientRole})
+	if err != nil {
+		return err
+	}
 	user := &entities.User{
 		Email:    req.Email,
 		Password: string(hashedPassword),
 		Name:     req.Name,
-		Roles:    []entities.Role{{Name: "user"}}, // Rol por defecto
+		Roles:    roles, // Rol por defecto
 	}
 
 	return s.userRepo.CreateUser(user)
@@ -67,16 +78,23 @@ func (s *authService) Login(req *request.LoginRequest) (*response.AuthResponse,
 	}
 
 	// Generar el token de acceso
-	accessToken, err := util.GenerateAccessToken(user.ID, s.jwtSecret)
+	accessToken, err := util.GenerateAccessToken(principalOf(user), s.jwtSecret)
 	if err != nil {
 		return nil, err
 	}
 
-	// Generar el token de refresco
-	refreshToken, err := util.GenerateRefreshToken(user.ID, s.jwtSecret)
+	// Generar el token de refresco, que inicia una nueva familia (sesión)
+	familyID, err := util.NewTokenID()
 	if err != nil {
 		return nil, err
 	}
+	refreshToken, stored, err := s.newRefreshToken(user.ID, familyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.Create(stored); err != nil {
+		return nil, err
+	}
 
 	authResponse := &response.AuthResponse{
 		AccessToken:  accessToken,
@@ -86,42 +104,73 @@ func (s *authService) Login(req *request.LoginRequest) (*response.AuthResponse,
 	return authResponse, nil
 }
 
+// Refresh rotates a refresh token: the presented token is revoked and a new pair is issued in
+// the same family. Presenting a token that was already rotated revokes the whole family.
 func (s *authService) Refresh(refreshToken string) (*response.AuthResponse, error) {
-	token, err := util.ValidateToken(refreshToken, s.jwtSecret)
+	current, err := s.storedRefreshToken(refreshToken)
 	if err != nil {
 		return nil, err
 	}
 
-	// Obtener los claims como jwt.MapClaims
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok || !token.Valid {
-		return nil, errors.New("token de refresco inválido")
+	// Un token ya rotado o revocado indica que pudo ser robado: se revoca toda la sesión
+	if current.RevokedAt != nil {
+		if err := s.refreshTokenRepo.RevokeFamily(current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, repository.ErrRefreshTokenReused
 	}
-
-	// Obtener el ID del usuario de los claims
-	userID, ok := claims["user_id"].(float64)
-	if !ok {
-		return nil, errors.New("token de refresco inválido")
+	if time.Now().After(current.ExpiresAt) {
+		return nil, errors.New("token de refresco expirado")
 	}
 
-	// Verificar si el usuario existe (opcional, pero recomendado)
-	_, err = s.userRepo.GetUserByID(uint(userID))
+	// Verificar si el usuario existe y obtener sus roles actuales
+	user, err := s.userRepo.GetUserByID(current.UserID)
 	if err != nil {
 		return nil, errors.New("usuario no encontrado")
 	}
 
 	// Generar un nuevo token de acceso
-	newAccessToken, err := util.GenerateAccessToken(uint(userID), s.jwtSecret)
+	newAccessToken, err := util.GenerateAccessToken(principalOf(user), s.jwtSecret)
 	if err != nil {
 		return nil, err
 	}
 
+	// Rotar el token de refresco dentro de la misma familia
+	newRefreshToken, next, err := s.newRefreshToken(user.ID, current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.Rotate(current, next); err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenReused) {
+			if err := s.refreshTokenRepo.RevokeFamily(current.FamilyID); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
 	authResponse := &response.AuthResponse{
-		AccessToken: newAccessToken,
+		AccessToken:  newAccessToken,
+		RefreshToken: newRefreshToken,
 	}
 	return authResponse, nil
 }
 
+// Logout revokes the session of a refresh token. Access tokens already issued stay valid
+// until they expire.
+func (s *authService) Logout(refreshToken string) error {
+	current, err := s.storedRefreshToken(refreshToken)
+	if err != nil {
+		return err
+	}
+	return s.refreshTokenRepo.RevokeFamily(current.FamilyID)
+}
+
+// LogoutAll revokes every session of a user.
+func (s *authService) LogoutAll(userID uint) error {
+	return s.refreshTokenRepo.RevokeAllForUser(userID)
+}
+
 func (s *authService) ValidateToken(tokenString string) (jwt.MapClaims, error) {
 	token, err := util.ValidateToken(tokenString, s.jwtSecret)
 	if err != nil {
@@ -161,3 +210,64 @@ func (s *authService) ResetPassword(req *request.ResetPasswordRequest, userID ui
 	// Guardar el usuario actualizado en la base de datos
 	return s.userRepo.UpdateUser(user)
 }
+
+// roleNames returns the names of the roles of a user, as carried by access tokens.
+func roleNames(user *entities.User) []string {
+	names := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		names = append(names, role.Name)
+	}
+	return names
+}
+
+// principalOf returns the identity carried by the access tokens of a user. The user must be
+// loaded with its roles, admin and client records.
+func principalOf(user *entities.User) auth.Principal {
+	principal := auth.Principal{UserID: user.ID}
+	for _, role := range user.Roles {
+		principal.Roles = append(principal.Roles, enums.RoleName(role.Name))
+	}
+	if user.Admin != nil {
+		principal.EstablishmentID = user.Admin.EstablishmentID
+	} else if user.EstablishmentID != nil {
+		principal.EstablishmentID = *user.EstablishmentID
+	}
+	if user.Client != nil {
+		principal.ClientID = user.Client.ID
+	}
+	return principal
+}
+
+// newRefreshToken signs a refresh token in a family and returns the record to persist for it.
+func (s *authService) newRefreshToken(userID uint, familyID string) (string, *entities.RefreshToken, error) {
+	tokenID, err := util.NewTokenID()
+	if err != nil {
+		return "", nil, err
+	}
+	expiresAt := time.Now().Add(util.RefreshTokenTTL)
+
+	token, err := util.GenerateRefreshToken(userID, tokenID, expiresAt, s.jwtSecret)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &entities.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: util.HashToken(tokenID),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// storedRefreshToken validates a refresh token and retrieves its record.
+func (s *authService) storedRefreshToken(refreshToken string) (*entities.RefreshToken, error) {
+	claims, err := util.ParseRefreshToken(refreshToken, s.jwtSecret)
+	if err != nil {
+		return nil, errors.New("token de refresco inválido")
+	}
+
+	stored, err := s.refreshTokenRepo.GetByHash(util.HashToken(claims.ID))
+	if err != nil {
+		return nil, errors.New("token de refresco inválido")
+	}
+	return stored, nil
+}
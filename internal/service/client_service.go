@@ -1,7 +1,10 @@
 package service
 
 import (
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/model/entities/enums"
 	"errors"
 
 	"ApiRestFinance/internal/repository"
@@ -9,7 +12,7 @@ import (
 
 type ClientService interface {
 	CreateClient(client *entities.Client) error
-	GetAllClients() ([]entities.Client, error)
+	GetAllClients(query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[entities.Client], error)
 	GetClientByID(clientID uint) (*entities.Client, error)
 	UpdateClient(client *entities.Client) error
 	DeleteClient(clientID uint) error
@@ -18,10 +21,11 @@ type ClientService interface {
 type clientService struct {
 	clientRepo repository.ClientRepository
 	userRepo   repository.UserRepository // Agrega userRepo
+	roleRepo   repository.RoleRepository
 }
 
-func NewClientService(clientRepo repository.ClientRepository, userRepo repository.UserRepository) ClientService { // Actualiza la firma
-	return &clientService{clientRepo: clientRepo, userRepo: userRepo}
+func NewClientService(clientRepo repository.ClientRepository, userRepo repository.UserRepository, roleRepo repository.RoleRepository) ClientService { // Actualiza la firma
+	return &clientService{clientRepo: clientRepo, userRepo: userRepo, roleRepo: roleRepo}
 }
 
 func (s *clientService) CreateClient(client *entities.Client) error {
@@ -30,11 +34,14 @@ func (s *clientService) CreateClient(client *entities.Client) error {
 	if existingClient != nil {
 		return errors.New("ya existe un cliente asociado a este usuario")
 	}
-	return s.clientRepo.CreateClient(client)
+	if err := s.clientRepo.CreateClient(client); err != nil {
+		return err
+	}
+	return s.roleRepo.GrantRole(client.UserID, enums.ClientRole)
 }
 
-func (s *clientService) GetAllClients() ([]entities.Client, error) {
-	return s.clientRepo.GetAllClients()
+func (s *clientService) GetAllClients(query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[entities.Client], error) {
+	return s.clientRepo.GetAllClients(query, tenant)
 }
 
 func (s *clientService) GetClientByID(clientID uint) (*entities.Client, error) {
@@ -3,14 +3,18 @@ package service
 import (
 	"errors"
 	"fmt"
-	"math"
+	"strings"
 	"time"
 
+	"ApiRestFinance/internal/auth"
+	"ApiRestFinance/internal/finance"
 	"ApiRestFinance/internal/model/dto/request"
 	"ApiRestFinance/internal/model/dto/response"
 	"ApiRestFinance/internal/model/entities"
 	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/model/money"
 	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/scoring"
 	"gorm.io/gorm"
 )
 
@@ -20,23 +24,26 @@ type CreditAccountService interface {
 	GetCreditAccountByID(id uint) (*response.CreditAccountResponse, error)
 	UpdateCreditAccount(id uint, req request.UpdateCreditAccountRequest) (*response.CreditAccountResponse, error)
 	DeleteCreditAccount(id uint) error
-	GetCreditAccountsByEstablishmentID(establishmentID uint) ([]response.CreditAccountResponse, error) // Return type corrected
-	GetCreditAccountsByClientID(clientID uint) ([]response.CreditAccountResponse, error)
+	GetCreditAccountsByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.CreditAccountResponse], error)
+	GetCreditAccountsByClientID(clientID uint, query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[response.CreditAccountResponse], error)
 	ApplyInterestToAllAccounts(establishmentID uint) error
 	ApplyLateFeesToAllAccounts(establishmentID uint) error
 	GetAdminDebtSummary(establishmentID uint) ([]response.AdminDebtSummary, error)
-	ProcessPurchase(creditAccountID uint, amount float64, description string) error
-	ProcessPayment(creditAccountID uint, amount float64, description string) error
+	ProcessPurchase(creditAccountID uint, amount money.Money, description string) error
+	ProcessItemizedPurchase(creditAccountID uint, req request.CreatePurchaseRequest) (*response.TransactionResponse, error)
+	ProcessPayment(creditAccountID uint, amount money.Money, description string) error
 
 	CreateCreditRequest(req request.CreateCreditRequest) (*response.CreditRequestResponse, error)
 	GetCreditRequestByID(id uint) (*response.CreditRequestResponse, error)
-	ApproveCreditRequest(creditRequestID uint, adminID uint) (*response.CreditAccountResponse, error)
-	RejectCreditRequest(creditRequestID uint, adminID uint) error
-	GetPendingCreditRequests(establishmentID uint) ([]response.CreditRequestResponse, error)
+	ApproveCreditRequest(creditRequestID uint, caller auth.Principal) (*response.CreditAccountResponse, error)
+	RejectCreditRequest(creditRequestID uint, caller auth.Principal) error
+	GetPendingCreditRequests(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.CreditRequestResponse], error)
 	AssignCreditAccountToClient(creditAccountID, clientID uint) (*response.CreditAccountResponse, error)
 	CalculateDueDate(account entities.CreditAccount) time.Time
 	GetNumberOfDues(account entities.CreditAccount) int
-	CalculateInterest(creditAccount entities.CreditAccount) float64
+	CalculateInterest(creditAccount entities.CreditAccount) money.Money
+	RegenerateSchedule(creditAccountID uint, req request.RegenerateScheduleRequest) ([]response.InstallmentResponse, error)
+	Prepay(creditAccountID uint, req request.PrepaymentRequest) (*response.PrepaymentResponse, error)
 }
 
 type creditAccountService struct {
@@ -45,20 +52,26 @@ type creditAccountService struct {
 	clientRepo        repository.ClientRepository
 	establishmentRepo repository.EstablishmentRepository
 	installmentRepo   repository.InstallmentRepository
+	creditPolicyRepo  repository.CreditPolicyRepository
 }
 
 // NewCreditAccountService creates a new instance of CreditAccountService.
-func NewCreditAccountService(creditAccountRepo repository.CreditAccountRepository, transactionRepo repository.TransactionRepository, clientRepo repository.ClientRepository, establishmentRepo repository.EstablishmentRepository, installmentRepo repository.InstallmentRepository) CreditAccountService {
+func NewCreditAccountService(creditAccountRepo repository.CreditAccountRepository, transactionRepo repository.TransactionRepository, clientRepo repository.ClientRepository, establishmentRepo repository.EstablishmentRepository, installmentRepo repository.InstallmentRepository, creditPolicyRepo repository.CreditPolicyRepository) CreditAccountService {
 	return &creditAccountService{
 		creditAccountRepo: creditAccountRepo,
 		transactionRepo:   transactionRepo,
 		clientRepo:        clientRepo,
 		establishmentRepo: establishmentRepo,
 		installmentRepo:   installmentRepo,
+		creditPolicyRepo:  creditPolicyRepo,
 	}
 }
 
 func (s *creditAccountService) CreateCreditAccount(req request.CreateCreditAccountRequest) (*response.CreditAccountResponse, error) {
+	if err := validateTerm(req.CreditType, req.Term); err != nil {
+		return nil, err
+	}
+
 	// 1. Check if the client exists
 	clientResponse, err := s.clientRepo.GetClientByID(req.ClientID)
 	if err != nil {
@@ -94,8 +107,14 @@ func (s *creditAccountService) CreateCreditAccount(req request.CreateCreditAccou
 		MonthlyDueDate:          req.MonthlyDueDate,
 		InterestRate:            req.InterestRate,
 		InterestType:            req.InterestType,
+		PenaltyInterestRate:     req.PenaltyInterestRate,
+		DayCount:                defaultDayCount(req.DayCount),
+		Compounding:             defaultCompounding(req.Compounding),
 		CreditType:              req.CreditType,
+		Term:                    req.Term,
+		AmortizationMethod:      defaultAmortizationMethod(req.AmortizationMethod),
 		GracePeriod:             req.GracePeriod,
+		GraceType:               defaultGraceType(req.GraceType),
 		IsBlocked:               false, // Initially not blocked
 		LastInterestAccrualDate: time.Now(),
 		CurrentBalance:          0.0, // Initial balance is zero
@@ -124,53 +143,55 @@ func (s *creditAccountService) DeleteCreditAccount(id uint) error {
 	return s.creditAccountRepo.Delete(id)
 }
 
-func (s *creditAccountService) GetCreditAccountsByEstablishmentID(establishmentID uint) ([]response.CreditAccountResponse, error) {
-	creditAccounts, err := s.creditAccountRepo.GetByEstablishmentID(establishmentID)
+func (s *creditAccountService) GetCreditAccountsByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.CreditAccountResponse], error) {
+	creditAccounts, err := s.creditAccountRepo.ListByEstablishmentID(establishmentID, query)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving credit accounts: %w", err)
 	}
-
-	creditAccountResponses := make([]response.CreditAccountResponse, len(creditAccounts))
-	for i, account := range creditAccounts {
-		creditAccountResponses[i] = account
-	}
-	return creditAccountResponses, nil
+	return creditAccounts, nil
 }
 
-func (s *creditAccountService) GetCreditAccountsByClientID(clientID uint) ([]response.CreditAccountResponse, error) {
-	return s.creditAccountRepo.GetByClientID(clientID)
+func (s *creditAccountService) GetCreditAccountsByClientID(clientID uint, query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[response.CreditAccountResponse], error) {
+	return s.creditAccountRepo.GetByClientID(clientID, query, tenant)
 }
 
 // ApplyInterestToAllAccounts applies interest to all eligible credit accounts within an establishment.
+// An account that fails does not stop the run; the failures are returned together at the end.
 func (s *creditAccountService) ApplyInterestToAllAccounts(establishmentID uint) error {
 	creditAccounts, err := s.creditAccountRepo.GetByEstablishmentID(establishmentID)
 	if err != nil {
 		return fmt.Errorf("error retrieving credit accounts: %w", err)
 	}
 
+	// Use the same cut-off date for every account of the run
+	now := time.Now()
+	var failures []error
 	for _, account := range creditAccounts {
-		if err := s.creditAccountRepo.ApplyInterest(account.ID); err != nil {
-			return fmt.Errorf("error applying interest to account %d: %w", account.ID, err)
+		if err := s.creditAccountRepo.ApplyInterest(account.ID, now); err != nil {
+			failures = append(failures, fmt.Errorf("error applying interest to account %d: %w", account.ID, err))
 		}
 	}
 
-	return nil
+	return errors.Join(failures...)
 }
 
 // ApplyLateFeesToAllAccounts applies late fees to all eligible credit accounts within an establishment.
+// Accounts without an applicable rule are skipped, and an account that fails does not stop the run;
+// the failures are returned together at the end.
 func (s *creditAccountService) ApplyLateFeesToAllAccounts(establishmentID uint) error {
 	overdueAccounts, err := s.creditAccountRepo.GetOverdueAccounts(establishmentID)
 	if err != nil {
 		return fmt.Errorf("error retrieving overdue accounts: %w", err)
 	}
 
+	var failures []error
 	for _, account := range overdueAccounts {
-		if err := s.creditAccountRepo.ApplyLateFee(account.ID); err != nil {
-			return fmt.Errorf("error applying late fee to account %d: %w", account.ID, err)
+		if err := s.creditAccountRepo.ApplyLateFee(account.ID); err != nil && !errors.Is(err, repository.ErrNoLateFeeRule) {
+			failures = append(failures, fmt.Errorf("error applying late fee to account %d: %w", account.ID, err))
 		}
 	}
 
-	return nil
+	return errors.Join(failures...)
 }
 
 // GetAdminDebtSummary retrieves a summary of debts owed to an establishment.
@@ -206,13 +227,23 @@ func (s *creditAccountService) GetAdminDebtSummary(establishmentID uint) ([]resp
 }
 
 // ProcessPurchase processes a purchase on a credit account.
-func (s *creditAccountService) ProcessPurchase(creditAccountID uint, amount float64, description string) error {
+func (s *creditAccountService) ProcessPurchase(creditAccountID uint, amount money.Money, description string) error {
 	// The service method now simply calls the repository method
 	return s.creditAccountRepo.ProcessPurchase(creditAccountID, amount, description)
 }
 
+// ProcessItemizedPurchase charges products of the establishment to a credit account and takes them out of stock.
+func (s *creditAccountService) ProcessItemizedPurchase(creditAccountID uint, req request.CreatePurchaseRequest) (*response.TransactionResponse, error) {
+	purchase, err := s.creditAccountRepo.ProcessItemizedPurchase(creditAccountID, req)
+	if err != nil {
+		return nil, fmt.Errorf("error processing itemized purchase: %w", err)
+	}
+
+	return purchase, nil
+}
+
 // ProcessPayment processes a payment towards a credit account.
-func (s *creditAccountService) ProcessPayment(creditAccountID uint, amount float64, description string) error {
+func (s *creditAccountService) ProcessPayment(creditAccountID uint, amount money.Money, description string) error {
 	// The service method now simply calls the repository method
 	return s.creditAccountRepo.ProcessPayment(creditAccountID, amount, description)
 }
@@ -223,6 +254,11 @@ func (s *creditAccountService) CalculateDueDate(account entities.CreditAccount)
 	today := time.Now()
 
 	if account.CreditType == enums.ShortTerm {
+		// Inside the grace period the first payment is deferred until the grace ends
+		if finance.InGracePeriod(account, today) {
+			return finance.DueDate(account.CreatedAt, account.MonthlyDueDate, account.GracePeriod+1)
+		}
+
 		// If short-term, due date is the next month's due date
 		return time.Date(today.Year(), today.Month()+1, account.MonthlyDueDate, 0, 0, 0, 0, time.UTC) // Use +1 to add a month
 	} else { // LongTerm
@@ -276,25 +312,15 @@ func (s *creditAccountService) GetNumberOfDues(account entities.CreditAccount) i
 	return numberOfDues
 }
 
-func (s *creditAccountService) CalculateInterest(creditAccount entities.CreditAccount) float64 {
-	var interest float64
+func (s *creditAccountService) CalculateInterest(creditAccount entities.CreditAccount) money.Money {
+	var interest money.Money
 	today := time.Now()
+	terms := finance.TermsFor(creditAccount)
 
 	if creditAccount.CreditType == enums.ShortTerm {
-		// Short-Term Interest Calculation
-
-		// Calculate the number of days since the last interest accrual
-		daysSinceLastAccrual := today.Sub(creditAccount.LastInterestAccrualDate).Hours() / 24
-
-		// Check if it's time to accrue interest (at least a month has passed)
-		if daysSinceLastAccrual >= daysInMonth(creditAccount.LastInterestAccrualDate.Month(), creditAccount.LastInterestAccrualDate.Year()) {
-			if creditAccount.InterestType == enums.Nominal {
-				// Nominal Interest: Interest = Principal * (Rate/100) * (Time in years)
-				interest = creditAccount.CurrentBalance * (creditAccount.InterestRate / 100) * (daysSinceLastAccrual / 365.0)
-			} else if creditAccount.InterestType == enums.Effective {
-				// Effective Interest: Interest = Principal * ((1 + Rate/100)^(Time in years) - 1)
-				interest = creditAccount.CurrentBalance * (math.Pow(1+(creditAccount.InterestRate/100), daysSinceLastAccrual/365.0) - 1)
-			}
+		// Short-Term Interest Calculation: only accrue once a full month has passed
+		if finance.AccrualDue(creditAccount, today) {
+			interest = finance.AccruedInterest(creditAccount, today)
 		}
 
 	} else { // LongTerm
@@ -306,21 +332,14 @@ func (s *creditAccountService) CalculateInterest(creditAccount entities.CreditAc
 		}
 
 		for _, installment := range installments {
-			// Only calculate interest on pending installments
-			if installment.Status == enums.Pending {
-				// Calculate days until the installment's due date
-				daysUntilDueDate := time.Until(installment.DueDate).Hours() / 24
-
-				// Check if the due date is in the future
-				if daysUntilDueDate > 0 {
-					if creditAccount.InterestType == enums.Nominal {
-						// Nominal Interest for Installment
-						interest += installment.Amount * (creditAccount.InterestRate / 100) * (daysUntilDueDate / 365.0)
-					} else if creditAccount.InterestType == enums.Effective {
-						// Effective Interest for Installment
-						interest += installment.Amount * (math.Pow(1+(creditAccount.InterestRate/100), daysUntilDueDate/365.0) - 1)
-					}
-				}
+			// Only count interest on pending installments; scheduled ones carry their own breakdown
+			if installment.Status != enums.Pending {
+				continue
+			}
+			if installment.Interest.IsPositive() {
+				interest += installment.Interest
+			} else {
+				interest += terms.Interest(installment.Amount, finance.DaysBetween(today, installment.DueDate))
 			}
 		}
 	}
@@ -328,12 +347,51 @@ func (s *creditAccountService) CalculateInterest(creditAccount entities.CreditAc
 	return interest
 }
 
-// Helper function to get the number of days in a month
-func daysInMonth(month time.Month, year int) float64 {
-	return float64(time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day())
+// defaultAmortizationMethod falls back to fixed payments (French method) when no method is given.
+func defaultAmortizationMethod(method enums.AmortizationMethod) enums.AmortizationMethod {
+	if method == "" {
+		return enums.French
+	}
+	return method
+}
+
+// defaultGraceType falls back to partial grace (interest-only payments) when no type is given.
+func defaultGraceType(graceType enums.GraceType) enums.GraceType {
+	if graceType == "" {
+		return enums.PartialGrace
+	}
+	return graceType
+}
+
+// validateTerm checks that LONG_TERM credit has a number of installments to amortize.
+func validateTerm(creditType enums.CreditType, term int) error {
+	if creditType == enums.LongTerm && term <= 0 {
+		return errors.New("term is required for LONG_TERM credit")
+	}
+	return nil
+}
+
+// defaultDayCount falls back to the 360-day commercial year when no convention is given.
+func defaultDayCount(dayCount enums.DayCountConvention) enums.DayCountConvention {
+	if dayCount == "" {
+		return enums.Actual360
+	}
+	return dayCount
+}
+
+// defaultCompounding falls back to monthly capitalization when no frequency is given.
+func defaultCompounding(compounding enums.CompoundingFrequency) enums.CompoundingFrequency {
+	if compounding == "" {
+		return enums.MonthlyCompounding
+	}
+	return compounding
 }
 
 func (s *creditAccountService) CreateCreditRequest(req request.CreateCreditRequest) (*response.CreditRequestResponse, error) {
+	if err := validateTerm(req.CreditType, req.Term); err != nil {
+		return nil, err
+	}
+
 	// 1. Check if a credit account already exists for the client and establishment
 	exists, err := s.creditAccountRepo.ExistsByClientAndEstablishment(req.ClientID, req.EstablishmentID)
 	if err != nil {
@@ -349,20 +407,60 @@ func (s *creditAccountService) CreateCreditRequest(req request.CreateCreditReque
 		EstablishmentID:      req.EstablishmentID,
 		RequestedCreditLimit: req.RequestedCreditLimit,
 		MonthlyDueDate:       req.MonthlyDueDate,
+		InterestRate:         req.InterestRate,
 		InterestType:         req.InterestType,
+		DayCount:             defaultDayCount(req.DayCount),
+		Compounding:          defaultCompounding(req.Compounding),
 		CreditType:           req.CreditType,
+		Term:                 req.Term,
+		AmortizationMethod:   defaultAmortizationMethod(req.AmortizationMethod),
 		GracePeriod:          req.GracePeriod,
+		GraceType:            defaultGraceType(req.GraceType),
 		Status:               entities.Pending,
 	}
 
+	// 3. Score the client and apply the credit policy of the establishment
+	if err := s.decideCreditRequest(&creditRequest); err != nil {
+		return nil, err
+	}
+
+	// 4. Store the request, opening the credit account of automatically approved requests
 	if err := s.creditAccountRepo.CreateCreditRequest(&creditRequest); err != nil { // Use the repository method
 		return nil, fmt.Errorf("error creating credit request: %w", err)
 	}
 
-	// 3. Map creditRequest to CreditRequestResponse and return
+	// 5. Map creditRequest to CreditRequestResponse and return
 	return getCreditRequestResponse(&creditRequest), nil
 }
 
+// decideCreditRequest scores the client of a credit request across every establishment and
+// applies the credit policy of the establishment, recording the score, the decision and its
+// reasons on the request. Rejected requests are closed right away; approved ones are left
+// pending until their credit account is opened.
+func (s *creditAccountService) decideCreditRequest(creditRequest *entities.CreditRequest) error {
+	now := time.Now()
+	factors, err := s.creditAccountRepo.GetScoringFactors(creditRequest.ClientID, now)
+	if err != nil {
+		return fmt.Errorf("error scoring client: %w", err)
+	}
+	policy, err := s.creditPolicyRepo.GetByEstablishmentID(creditRequest.EstablishmentID)
+	if err != nil {
+		return fmt.Errorf("error retrieving credit policy: %w", err)
+	}
+
+	result := scoring.Score(*factors, now)
+	decision, reason := scoring.Decide(result, policy, *creditRequest)
+	creditRequest.Score = &result.Score
+	creditRequest.Decision = decision
+	creditRequest.DecisionReasons = strings.Join(append(result.Reasons, reason), "\n")
+	if decision == enums.AutoRejected {
+		creditRequest.Status = entities.Rejected
+		creditRequest.RejectedAt = &now
+	}
+
+	return nil
+}
+
 func (s *creditAccountService) GetCreditRequestByID(id uint) (*response.CreditRequestResponse, error) {
 	creditRequest, err := s.creditAccountRepo.GetCreditRequestByID(id) // Use the repository method
 	if err != nil {
@@ -372,16 +470,16 @@ func (s *creditAccountService) GetCreditRequestByID(id uint) (*response.CreditRe
 	return getCreditRequestResponse(creditRequest), nil
 }
 
-func (s *creditAccountService) ApproveCreditRequest(creditRequestID uint, adminID uint) (*response.CreditAccountResponse, error) {
+func (s *creditAccountService) ApproveCreditRequest(creditRequestID uint, caller auth.Principal) (*response.CreditAccountResponse, error) {
 	// 1. Retrieve the credit request using the repository (now preloads data)
 	creditRequest, err := s.creditAccountRepo.GetCreditRequestByID(creditRequestID)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving credit request: %w", err)
 	}
 
-	// 2. Check if the admin belongs to the establishment
-	if creditRequest.Establishment.Admin.UserID != adminID {
-		return nil, errors.New("admin does not have permission to approve this request")
+	// 2. Check if the caller administers the establishment
+	if !caller.Manages(creditRequest.EstablishmentID) {
+		return nil, fmt.Errorf("approving this request: %w", auth.ErrForbidden)
 	}
 
 	// 3. Check if the request is already approved or rejected
@@ -398,16 +496,16 @@ func (s *creditAccountService) ApproveCreditRequest(creditRequestID uint, adminI
 	return creditAccountResponse, nil
 }
 
-func (s *creditAccountService) RejectCreditRequest(creditRequestID uint, adminID uint) error {
+func (s *creditAccountService) RejectCreditRequest(creditRequestID uint, caller auth.Principal) error {
 	// 1. Retrieve the credit request
 	creditRequest, err := s.creditAccountRepo.GetCreditRequestByID(creditRequestID)
 	if err != nil {
 		return fmt.Errorf("error retrieving credit request: %w", err)
 	}
 
-	// 2. Check if the admin belongs to the establishment
-	if creditRequest.Establishment.Admin.UserID != adminID {
-		return errors.New("admin does not have permission to reject this request")
+	// 2. Check if the caller administers the establishment
+	if !caller.Manages(creditRequest.EstablishmentID) {
+		return fmt.Errorf("rejecting this request: %w", auth.ErrForbidden)
 	}
 
 	// 3. Check if the request is already approved or rejected
@@ -428,20 +526,26 @@ func (s *creditAccountService) RejectCreditRequest(creditRequestID uint, adminID
 	return nil
 }
 
-func (s *creditAccountService) GetPendingCreditRequests(establishmentID uint) ([]response.CreditRequestResponse, error) {
+func (s *creditAccountService) GetPendingCreditRequests(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.CreditRequestResponse], error) {
 	// 1. Retrieve credit requests using the repository method
-	creditRequests, err := s.creditAccountRepo.GetPendingCreditRequests(establishmentID)
+	creditRequests, err := s.creditAccountRepo.GetPendingCreditRequests(establishmentID, query)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving pending credit requests: %w", err)
 	}
 
 	// 2. Convert entities to responses
-	var creditRequestResponses []response.CreditRequestResponse
-	for _, creditRequest := range creditRequests {
-		creditRequestResponses = append(creditRequestResponses, *getCreditRequestResponse(&creditRequest))
+	page := &response.PageResponse[response.CreditRequestResponse]{
+		Items:      make([]response.CreditRequestResponse, 0, len(creditRequests.Items)),
+		Total:      creditRequests.Total,
+		Limit:      creditRequests.Limit,
+		Offset:     creditRequests.Offset,
+		NextCursor: creditRequests.NextCursor,
+	}
+	for _, creditRequest := range creditRequests.Items {
+		page.Items = append(page.Items, *getCreditRequestResponse(&creditRequest))
 	}
 
-	return creditRequestResponses, nil
+	return page, nil
 }
 
 func getCreditRequestResponse(creditRequest *entities.CreditRequest) *response.CreditRequestResponse {
@@ -451,17 +555,34 @@ func getCreditRequestResponse(creditRequest *entities.CreditRequest) *response.C
 		EstablishmentID:      creditRequest.EstablishmentID,
 		RequestedCreditLimit: creditRequest.RequestedCreditLimit,
 		MonthlyDueDate:       creditRequest.MonthlyDueDate,
+		InterestRate:         creditRequest.InterestRate,
 		InterestType:         creditRequest.InterestType,
+		DayCount:             creditRequest.DayCount,
+		Compounding:          creditRequest.Compounding,
 		CreditType:           creditRequest.CreditType,
+		Term:                 creditRequest.Term,
+		AmortizationMethod:   creditRequest.AmortizationMethod,
 		GracePeriod:          creditRequest.GracePeriod,
+		GraceType:            creditRequest.GraceType,
 		Status:               creditRequest.Status,
 		ApprovedAt:           creditRequest.ApprovedAt,
 		RejectedAt:           creditRequest.RejectedAt,
+		Score:                creditRequest.Score,
+		Decision:             creditRequest.Decision,
+		DecisionReasons:      decisionReasons(creditRequest.DecisionReasons),
 		CreatedAt:            creditRequest.CreatedAt,
 		UpdatedAt:            creditRequest.UpdatedAt,
 	}
 }
 
+// decisionReasons splits the decision reasons stored on a credit request.
+func decisionReasons(reasons string) []string {
+	if reasons == "" {
+		return []string{}
+	}
+	return strings.Split(reasons, "\n")
+}
+
 // AssignCreditAccountToClient assigns an existing credit account to a client.
 func (s *creditAccountService) AssignCreditAccountToClient(creditAccountID, clientID uint) (*response.CreditAccountResponse, error) {
 	// Call the repository method to perform the assignment
@@ -486,13 +607,24 @@ func creditAccountToResponse(creditAccount *entities.CreditAccount) *response.Cr
 		ClientID:                creditAccount.ClientID,
 		CreditLimit:             creditAccount.CreditLimit,
 		CurrentBalance:          creditAccount.CurrentBalance,
+		FeesBalance:             creditAccount.FeesBalance,
+		PenaltyInterestBalance:  creditAccount.PenaltyInterestBalance,
+		InterestBalance:         creditAccount.InterestBalance,
 		MonthlyDueDate:          creditAccount.MonthlyDueDate,
 		InterestRate:            creditAccount.InterestRate,
 		InterestType:            creditAccount.InterestType,
+		PenaltyInterestRate:     creditAccount.PenaltyInterestRate,
+		DayCount:                creditAccount.DayCount,
+		Compounding:             creditAccount.Compounding,
 		CreditType:              creditAccount.CreditType,
+		Term:                    creditAccount.Term,
+		AmortizationMethod:      creditAccount.AmortizationMethod,
 		GracePeriod:             creditAccount.GracePeriod,
+		GraceType:               creditAccount.GraceType,
 		IsBlocked:               creditAccount.IsBlocked,
+		BlockedByPolicy:         creditAccount.BlockedByPolicy,
 		LastInterestAccrualDate: creditAccount.LastInterestAccrualDate,
+		LastPenaltyAccrualDate:  creditAccount.LastPenaltyAccrualDate,
 		CreatedAt:               creditAccount.CreatedAt,
 		UpdatedAt:               creditAccount.UpdatedAt,
 		LateFeeRuleID:           creditAccount.LateFeeRuleID,
@@ -515,11 +647,22 @@ func responseToCreditAccount(res *response.CreditAccountResponse) *entities.Cred
 		MonthlyDueDate:          res.MonthlyDueDate,
 		InterestRate:            res.InterestRate,
 		InterestType:            res.InterestType,
+		PenaltyInterestRate:     res.PenaltyInterestRate,
+		DayCount:                res.DayCount,
+		Compounding:             res.Compounding,
 		CreditType:              res.CreditType,
+		Term:                    res.Term,
+		AmortizationMethod:      res.AmortizationMethod,
 		GracePeriod:             res.GracePeriod,
+		GraceType:               res.GraceType,
 		IsBlocked:               res.IsBlocked,
+		BlockedByPolicy:         res.BlockedByPolicy,
 		LastInterestAccrualDate: res.LastInterestAccrualDate,
+		LastPenaltyAccrualDate:  res.LastPenaltyAccrualDate,
 		CurrentBalance:          res.CurrentBalance,
+		FeesBalance:             res.FeesBalance,
+		PenaltyInterestBalance:  res.PenaltyInterestBalance,
+		InterestBalance:         res.InterestBalance,
 		LateFeeRuleID:           res.LateFeeRuleID,
 		// You can add Client and LateFeeRule data if needed
 		// You'll need to convert ClientResponse and LateFeeRuleResponse to entities as well
@@ -527,3 +670,24 @@ func responseToCreditAccount(res *response.CreditAccountResponse) *entities.Cred
 		// LateFeeRule:     // ... (map lateFeeRule response to entity)
 	}
 }
+
+// RegenerateSchedule rebuilds the unpaid installments of a LONG_TERM credit account from today.
+func (s *creditAccountService) RegenerateSchedule(creditAccountID uint, req request.RegenerateScheduleRequest) ([]response.InstallmentResponse, error) {
+	installments, err := s.creditAccountRepo.RegenerateSchedule(creditAccountID, req, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error regenerating installment schedule: %w", err)
+	}
+
+	return installments, nil
+}
+
+// Prepay pays off part or all of the outstanding principal of a LONG_TERM credit account today
+// and recalculates its schedule as the client chose.
+func (s *creditAccountService) Prepay(creditAccountID uint, req request.PrepaymentRequest) (*response.PrepaymentResponse, error) {
+	prepayment, err := s.creditAccountRepo.Prepay(creditAccountID, req, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error processing prepayment: %w", err)
+	}
+
+	return prepayment, nil
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/auth"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/scoring"
+)
+
+var (
+	// ErrLimitUnchanged is returned when a credit limit change requests the current limit.
+	ErrLimitUnchanged = errors.New("new credit limit equals the current limit")
+	// ErrLimitChangePending is returned when a credit account already has a change awaiting a decision.
+	ErrLimitChangePending = errors.New("credit account already has a pending credit limit change")
+)
+
+// CreditLimitService defines the interface for credit limit change operations.
+type CreditLimitService interface {
+	RequestLimitChange(creditAccountID uint, req request.CreateCreditLimitChangeRequest, caller auth.Principal) (*response.CreditLimitChangeResponse, error)
+	GetLimitChanges(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.CreditLimitChangeResponse], error)
+	ApproveLimitChange(id uint, override bool, caller auth.Principal) (*response.CreditLimitChangeResponse, error)
+	RejectLimitChange(id uint, caller auth.Principal) (*response.CreditLimitChangeResponse, error)
+	ReviewCreditLimits(establishmentID uint) error
+}
+
+type creditLimitService struct {
+	creditLimitChangeRepo repository.CreditLimitChangeRepository
+	creditAccountRepo     repository.CreditAccountRepository
+	creditPolicyRepo      repository.CreditPolicyRepository
+}
+
+// NewCreditLimitService creates a new instance of CreditLimitService.
+func NewCreditLimitService(creditLimitChangeRepo repository.CreditLimitChangeRepository, creditAccountRepo repository.CreditAccountRepository, creditPolicyRepo repository.CreditPolicyRepository) CreditLimitService {
+	return &creditLimitService{
+		creditLimitChangeRepo: creditLimitChangeRepo,
+		creditAccountRepo:     creditAccountRepo,
+		creditPolicyRepo:      creditPolicyRepo,
+	}
+}
+
+// RequestLimitChange records a change of the credit limit of an account for an establishment
+// admin to approve. An account has at most one pending change.
+func (s *creditLimitService) RequestLimitChange(creditAccountID uint, req request.CreateCreditLimitChangeRequest, caller auth.Principal) (*response.CreditLimitChangeResponse, error) {
+	creditAccount, err := s.creditAccountRepo.GetByID(creditAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credit account: %w", err)
+	}
+	if req.NewLimit == creditAccount.CreditLimit {
+		return nil, ErrLimitUnchanged
+	}
+
+	pending, err := s.creditLimitChangeRepo.HasPending(creditAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, ErrLimitChangePending
+	}
+
+	return s.creditLimitChangeRepo.Create(&entities.CreditLimitChange{
+		CreditAccountID:   creditAccountID,
+		RequestedByUserID: caller.UserID,
+		OldLimit:          creditAccount.CreditLimit,
+		NewLimit:          req.NewLimit,
+		Reason:            req.Reason,
+		Status:            entities.Pending,
+	})
+}
+
+// GetLimitChanges retrieves a page of the credit limit changes of an account.
+func (s *creditLimitService) GetLimitChanges(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.CreditLimitChangeResponse], error) {
+	return s.creditLimitChangeRepo.ListByCreditAccountID(creditAccountID, query)
+}
+
+// ApproveLimitChange applies a pending credit limit change. Limits below the balance of the
+// account need an override.
+func (s *creditLimitService) ApproveLimitChange(id uint, override bool, caller auth.Principal) (*response.CreditLimitChangeResponse, error) {
+	change, err := s.creditLimitChangeRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credit limit change: %w", err)
+	}
+	if !caller.Manages(change.CreditAccount.EstablishmentID) {
+		return nil, fmt.Errorf("approving this credit limit change: %w", auth.ErrForbidden)
+	}
+
+	approved, err := s.creditLimitChangeRepo.Approve(id, caller.UserID, override)
+	if err != nil {
+		return nil, fmt.Errorf("error approving credit limit change: %w", err)
+	}
+
+	return approved, nil
+}
+
+// RejectLimitChange closes a pending credit limit change without applying it.
+func (s *creditLimitService) RejectLimitChange(id uint, caller auth.Principal) (*response.CreditLimitChangeResponse, error) {
+	change, err := s.creditLimitChangeRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credit limit change: %w", err)
+	}
+	if !caller.Manages(change.CreditAccount.EstablishmentID) {
+		return nil, fmt.Errorf("rejecting this credit limit change: %w", auth.ErrForbidden)
+	}
+
+	rejected, err := s.creditLimitChangeRepo.Reject(id, caller.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error rejecting credit limit change: %w", err)
+	}
+
+	return rejected, nil
+}
+
+// ReviewCreditLimits scores the clients of every unblocked credit account of an establishment
+// and proposes the limit changes its credit policy calls for. Proposals are left pending for
+// an establishment admin; accounts with a pending change are skipped.
+func (s *creditLimitService) ReviewCreditLimits(establishmentID uint) error {
+	policy, err := s.creditPolicyRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return fmt.Errorf("error retrieving credit policy: %w", err)
+	}
+	if policy == nil || !policy.Enabled || policy.LimitReviewPercent <= 0 {
+		return nil
+	}
+
+	creditAccounts, err := s.creditAccountRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return fmt.Errorf("error retrieving credit accounts: %w", err)
+	}
+
+	now := time.Now()
+	for _, account := range creditAccounts {
+		if account.IsBlocked {
+			continue
+		}
+		pending, err := s.creditLimitChangeRepo.HasPending(account.ID)
+		if err != nil {
+			return err
+		}
+		if pending {
+			continue
+		}
+
+		factors, err := s.creditAccountRepo.GetScoringFactors(account.ClientID, now)
+		if err != nil {
+			return fmt.Errorf("error scoring client %d: %w", account.ClientID, err)
+		}
+		newLimit, reason, ok := scoring.ReviewLimit(scoring.Score(*factors, now), policy, account.CreditLimit, account.CurrentBalance)
+		if !ok {
+			continue
+		}
+
+		if _, err := s.creditLimitChangeRepo.Create(&entities.CreditLimitChange{
+			CreditAccountID: account.ID,
+			OldLimit:        account.CreditLimit,
+			NewLimit:        newLimit,
+			Reason:          reason,
+			Automatic:       true,
+			Status:          entities.Pending,
+		}); err != nil {
+			return fmt.Errorf("error proposing credit limit change for account %d: %w", account.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"fmt"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CreditPolicyService defines the interface for credit policy service operations.
+type CreditPolicyService interface {
+	GetCreditPolicy(establishmentID uint) (*response.CreditPolicyResponse, error)
+	UpdateCreditPolicy(establishmentID uint, req request.UpdateCreditPolicyRequest) (*response.CreditPolicyResponse, error)
+}
+
+type creditPolicyService struct {
+	creditPolicyRepo repository.CreditPolicyRepository
+}
+
+// NewCreditPolicyService creates a new instance of CreditPolicyService.
+func NewCreditPolicyService(creditPolicyRepo repository.CreditPolicyRepository) CreditPolicyService {
+	return &creditPolicyService{creditPolicyRepo: creditPolicyRepo}
+}
+
+// GetCreditPolicy retrieves the credit policy of an establishment.
+func (s *creditPolicyService) GetCreditPolicy(establishmentID uint) (*response.CreditPolicyResponse, error) {
+	policy, err := s.creditPolicyRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credit policy: %w", err)
+	}
+	if policy == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return getCreditPolicyResponse(policy), nil
+}
+
+// UpdateCreditPolicy sets the credit policy of an establishment, creating it on first use.
+func (s *creditPolicyService) UpdateCreditPolicy(establishmentID uint, req request.UpdateCreditPolicyRequest) (*response.CreditPolicyResponse, error) {
+	policy, err := s.creditPolicyRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credit policy: %w", err)
+	}
+	if policy == nil {
+		policy = &entities.CreditPolicy{EstablishmentID: establishmentID}
+	}
+
+	policy.Enabled = req.Enabled
+	policy.AutoApproveMinScore = req.AutoApproveMinScore
+	policy.AutoRejectBelowScore = req.AutoRejectBelowScore
+	policy.MaxAutoApproveLimit = req.MaxAutoApproveLimit
+	policy.LimitReviewPercent = req.LimitReviewPercent
+	policy.MinInterestRate = req.MinInterestRate
+	policy.MaxGracePeriod = req.MaxGracePeriod
+	policy.MaxTerm = req.MaxTerm
+	if err := s.creditPolicyRepo.Save(policy); err != nil {
+		return nil, fmt.Errorf("error saving credit policy: %w", err)
+	}
+
+	return getCreditPolicyResponse(policy), nil
+}
+
+func getCreditPolicyResponse(policy *entities.CreditPolicy) *response.CreditPolicyResponse {
+	return &response.CreditPolicyResponse{
+		ID:                   policy.ID,
+		EstablishmentID:      policy.EstablishmentID,
+		Enabled:              policy.Enabled,
+		AutoApproveMinScore:  policy.AutoApproveMinScore,
+		AutoRejectBelowScore: policy.AutoRejectBelowScore,
+		MaxAutoApproveLimit:  policy.MaxAutoApproveLimit,
+		LimitReviewPercent:   policy.LimitReviewPercent,
+		MinInterestRate:      policy.MinInterestRate,
+		MaxGracePeriod:       policy.MaxGracePeriod,
+		MaxTerm:              policy.MaxTerm,
+		CreatedAt:            policy.CreatedAt,
+		UpdatedAt:            policy.UpdatedAt,
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/repository"
+	"gorm.io/gorm"
+)
+
+// DelinquencyService defines the interface for delinquency policy operations.
+type DelinquencyService interface {
+	GetDelinquencyPolicy(establishmentID uint) (*response.DelinquencyPolicyResponse, error)
+	UpdateDelinquencyPolicy(establishmentID uint, req request.UpdateDelinquencyPolicyRequest) (*response.DelinquencyPolicyResponse, error)
+	ApplyDelinquencyPolicy(establishmentID uint) error
+}
+
+type delinquencyService struct {
+	delinquencyPolicyRepo repository.DelinquencyPolicyRepository
+	creditAccountRepo     repository.CreditAccountRepository
+}
+
+// NewDelinquencyService creates a new instance of DelinquencyService.
+func NewDelinquencyService(delinquencyPolicyRepo repository.DelinquencyPolicyRepository, creditAccountRepo repository.CreditAccountRepository) DelinquencyService {
+	return &delinquencyService{
+		delinquencyPolicyRepo: delinquencyPolicyRepo,
+		creditAccountRepo:     creditAccountRepo,
+	}
+}
+
+// GetDelinquencyPolicy retrieves the delinquency policy of an establishment.
+func (s *delinquencyService) GetDelinquencyPolicy(establishmentID uint) (*response.DelinquencyPolicyResponse, error) {
+	policy, err := s.delinquencyPolicyRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving delinquency policy: %w", err)
+	}
+	if policy == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return getDelinquencyPolicyResponse(policy), nil
+}
+
+// UpdateDelinquencyPolicy sets the delinquency policy of an establishment, creating it on first use.
+func (s *delinquencyService) UpdateDelinquencyPolicy(establishmentID uint, req request.UpdateDelinquencyPolicyRequest) (*response.DelinquencyPolicyResponse, error) {
+	policy, err := s.delinquencyPolicyRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving delinquency policy: %w", err)
+	}
+	if policy == nil {
+		policy = &entities.DelinquencyPolicy{EstablishmentID: establishmentID}
+	}
+
+	policy.Enabled = req.Enabled
+	policy.BlockAfterDaysOverdue = req.BlockAfterDaysOverdue
+	policy.UnblockWhenCleared = req.UnblockWhenCleared
+	if err := s.delinquencyPolicyRepo.Save(policy); err != nil {
+		return nil, fmt.Errorf("error saving delinquency policy: %w", err)
+	}
+
+	return getDelinquencyPolicyResponse(policy), nil
+}
+
+// ApplyDelinquencyPolicy blocks the credit accounts of an establishment that have been overdue
+// for too long and, when the policy allows it, unblocks those it blocked once their arrears are
+// cleared. Blocks placed by staff or by write-offs are never lifted.
+func (s *delinquencyService) ApplyDelinquencyPolicy(establishmentID uint) error {
+	policy, err := s.delinquencyPolicyRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return fmt.Errorf("error retrieving delinquency policy: %w", err)
+	}
+	if policy == nil || !policy.Enabled {
+		return nil
+	}
+
+	daysOverdue, err := s.creditAccountRepo.GetDaysOverdue(establishmentID, time.Now())
+	if err != nil {
+		return err
+	}
+	creditAccounts, err := s.creditAccountRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return fmt.Errorf("error retrieving credit accounts: %w", err)
+	}
+
+	for _, account := range creditAccounts {
+		days, overdue := daysOverdue[account.ID]
+		switch {
+		case !account.IsBlocked && policy.BlockAfterDaysOverdue > 0 && days >= policy.BlockAfterDaysOverdue:
+			reason := fmt.Sprintf("Blocked by the delinquency policy: %d days overdue", days)
+			if err := s.creditAccountRepo.SetBlockedByPolicy(account.ID, true, reason); err != nil {
+				return fmt.Errorf("error blocking account %d: %w", account.ID, err)
+			}
+		case account.BlockedByPolicy && policy.UnblockWhenCleared && !overdue:
+			if err := s.creditAccountRepo.SetBlockedByPolicy(account.ID, false, "Unblocked by the delinquency policy: arrears cleared"); err != nil {
+				return fmt.Errorf("error unblocking account %d: %w", account.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getDelinquencyPolicyResponse(policy *entities.DelinquencyPolicy) *response.DelinquencyPolicyResponse {
+	return &response.DelinquencyPolicyResponse{
+		ID:                    policy.ID,
+		EstablishmentID:       policy.EstablishmentID,
+		Enabled:               policy.Enabled,
+		BlockAfterDaysOverdue: policy.BlockAfterDaysOverdue,
+		UnblockWhenCleared:    policy.UnblockWhenCleared,
+		CreatedAt:             policy.CreatedAt,
+		UpdatedAt:             policy.UpdatedAt,
+	}
+}
@@ -12,8 +12,8 @@ type InstallmentService interface {
 	GetInstallmentByID(id uint) (*response.InstallmentResponse, error)
 	UpdateInstallment(id uint, req request.UpdateInstallmentRequest) (*response.InstallmentResponse, error)
 	DeleteInstallment(id uint) error
-	GetInstallmentsByCreditAccountID(creditAccountID uint) ([]response.InstallmentResponse, error)
-	GetOverdueInstallments(creditAccountID uint) ([]response.InstallmentResponse, error)
+	GetInstallmentsByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error)
+	GetOverdueInstallments(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error)
 }
 
 type installmentService struct {
@@ -45,12 +45,12 @@ func (s *installmentService) DeleteInstallment(id uint) error {
 	return s.installmentRepo.Delete(id)
 }
 
-// GetInstallmentsByCreditAccountID retrieves all installments for a specific credit account.
-func (s *installmentService) GetInstallmentsByCreditAccountID(creditAccountID uint) ([]response.InstallmentResponse, error) {
-	return s.installmentRepo.GetByCreditAccountID(creditAccountID)
+// GetInstallmentsByCreditAccountID retrieves a page of the installments of a credit account.
+func (s *installmentService) GetInstallmentsByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error) {
+	return s.installmentRepo.ListByCreditAccountID(creditAccountID, query)
 }
 
-// GetOverdueInstallments retrieves all overdue installments for a specific credit account.
-func (s *installmentService) GetOverdueInstallments(creditAccountID uint) ([]response.InstallmentResponse, error) {
-	return s.installmentRepo.GetOverdueInstallments(creditAccountID)
+// GetOverdueInstallments retrieves a page of the overdue installments of a credit account.
+func (s *installmentService) GetOverdueInstallments(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.InstallmentResponse], error) {
+	return s.installmentRepo.GetOverdueInstallments(creditAccountID, query)
 }
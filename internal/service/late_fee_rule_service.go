@@ -11,8 +11,8 @@ type LateFeeRuleService interface {
 	GetLateFeeRuleByID(id uint) (*response.LateFeeRuleResponse, error)
 	UpdateLateFeeRule(id uint, req request.UpdateLateFeeRuleRequest) (*response.LateFeeRuleResponse, error)
 	DeleteLateFeeRule(id uint) error
-	GetAllLateFeeRules() ([]response.LateFeeRuleResponse, error)
-	GetLateFeeRulesByEstablishmentID(establishmentID uint) ([]response.LateFeeRuleResponse, error)
+	GetAllLateFeeRules(query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[response.LateFeeRuleResponse], error)
+	GetLateFeeRulesByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeRuleResponse], error)
 }
 
 type lateFeeRuleService struct {
@@ -44,12 +44,12 @@ func (s *lateFeeRuleService) DeleteLateFeeRule(id uint) error {
 	return s.lateFeeRuleRepo.Delete(id)
 }
 
-// GetAllLateFeeRules retrieves all late fee rules.
-func (s *lateFeeRuleService) GetAllLateFeeRules() ([]response.LateFeeRuleResponse, error) {
-	return s.lateFeeRuleRepo.GetAll()
+// GetAllLateFeeRules retrieves a page of the late fee rules visible to a tenant.
+func (s *lateFeeRuleService) GetAllLateFeeRules(query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[response.LateFeeRuleResponse], error) {
+	return s.lateFeeRuleRepo.GetAll(query, tenant)
 }
 
-// GetLateFeeRulesByEstablishmentID retrieves all late fee rules for a specific establishment.
-func (s *lateFeeRuleService) GetLateFeeRulesByEstablishmentID(establishmentID uint) ([]response.LateFeeRuleResponse, error) {
-	return s.lateFeeRuleRepo.GetByEstablishmentID(establishmentID)
+// GetLateFeeRulesByEstablishmentID retrieves a page of the late fee rules of an establishment.
+func (s *lateFeeRuleService) GetLateFeeRulesByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeRuleResponse], error) {
+	return s.lateFeeRuleRepo.GetByEstablishmentID(establishmentID, query)
 }
@@ -12,7 +12,7 @@ type LateFeeService interface {
 	GetLateFeeByID(id uint) (*response.LateFeeResponse, error)
 	UpdateLateFee(id uint, req request.UpdateLateFeeRequest) (*response.LateFeeResponse, error)
 	DeleteLateFee(id uint) error
-	GetLateFeesByCreditAccountID(creditAccountID uint) ([]response.LateFeeResponse, error)
+	GetLateFeesByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeResponse], error)
 }
 
 type lateFeeService struct {
@@ -44,7 +44,7 @@ func (s *lateFeeService) DeleteLateFee(id uint) error {
 	return s.lateFeeRepo.Delete(id)
 }
 
-// GetLateFeesByCreditAccountID retrieves all late fees for a specific credit account.
-func (s *lateFeeService) GetLateFeesByCreditAccountID(creditAccountID uint) ([]response.LateFeeResponse, error) {
-	return s.lateFeeRepo.GetByCreditAccountID(creditAccountID)
+// GetLateFeesByCreditAccountID retrieves a page of the late fees of a credit account.
+func (s *lateFeeService) GetLateFeesByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.LateFeeResponse], error) {
+	return s.lateFeeRepo.GetByCreditAccountID(creditAccountID, query)
 }
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"time"
+
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+)
+
+// LedgerService defines the interface for general ledger service operations.
+type LedgerService interface {
+	GetChart(establishmentID uint) ([]response.LedgerAccountResponse, error)
+	GetTrialBalance(establishmentID uint, asOf time.Time) (*response.TrialBalanceResponse, error)
+	GetJournalEntries(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.JournalEntryResponse], error)
+	ValidateCreditAccount(creditAccountID uint) (*response.LedgerBalanceResponse, error)
+}
+
+type ledgerService struct {
+	ledgerRepo repository.LedgerRepository
+}
+
+// NewLedgerService creates a new instance of LedgerService.
+func NewLedgerService(ledgerRepo repository.LedgerRepository) LedgerService {
+	return &ledgerService{ledgerRepo: ledgerRepo}
+}
+
+// GetChart retrieves the chart of accounts of an establishment.
+func (s *ledgerService) GetChart(establishmentID uint) ([]response.LedgerAccountResponse, error) {
+	return s.ledgerRepo.GetChart(establishmentID)
+}
+
+// GetTrialBalance retrieves the trial balance of an establishment at the end of asOf.
+func (s *ledgerService) GetTrialBalance(establishmentID uint, asOf time.Time) (*response.TrialBalanceResponse, error) {
+	endOfDay := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 23, 59, 59, 999999999, asOf.Location())
+	return s.ledgerRepo.GetTrialBalance(establishmentID, endOfDay)
+}
+
+// GetJournalEntries retrieves a page of the journal entries of a credit account.
+func (s *ledgerService) GetJournalEntries(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.JournalEntryResponse], error) {
+	return s.ledgerRepo.GetJournalEntries(creditAccountID, query)
+}
+
+// ValidateCreditAccount compares the balances of a credit account with its receivables in the ledger.
+func (s *ledgerService) ValidateCreditAccount(creditAccountID uint) (*response.LedgerBalanceResponse, error) {
+	return s.ledgerRepo.GetLedgerBalance(creditAccountID)
+}
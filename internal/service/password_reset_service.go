@@ -0,0 +1,94 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/mailer"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/entities"
+	"ApiRestFinance/internal/repository"
+	"ApiRestFinance/internal/util"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// passwordResetTokenTTL is how long an emailed reset link stays valid.
+const passwordResetTokenTTL = time.Hour
+
+// PasswordResetService defines the interface for the forgot-password flow.
+type PasswordResetService interface {
+	RequestPasswordReset(req request.ForgotPasswordRequest) error
+	ConfirmPasswordReset(req request.ConfirmPasswordResetRequest) error
+}
+
+type passwordResetService struct {
+	userRepo         repository.UserRepository
+	resetTokenRepo   repository.PasswordResetTokenRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	mailer           mailer.Mailer
+	resetURL         string
+}
+
+// NewPasswordResetService creates a new instance of PasswordResetService. The emailed link is
+// resetURL followed by the token.
+func NewPasswordResetService(userRepo repository.UserRepository, resetTokenRepo repository.PasswordResetTokenRepository, refreshTokenRepo repository.RefreshTokenRepository, mailer mailer.Mailer, resetURL string) PasswordResetService {
+	return &passwordResetService{userRepo: userRepo, resetTokenRepo: resetTokenRepo, refreshTokenRepo: refreshTokenRepo, mailer: mailer, resetURL: resetURL}
+}
+
+// RequestPasswordReset emails a single-use reset link. Unknown emails succeed silently, so the
+// endpoint does not reveal which emails have an account.
+func (s *passwordResetService) RequestPasswordReset(req request.ForgotPasswordRequest) error {
+	user, err := s.userRepo.GetUserByEmail(req.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+
+	token, err := util.NewTokenID()
+	if err != nil {
+		return err
+	}
+	resetToken := &entities.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: util.HashToken(token),
+		ExpiresAt: time.Now().Add(passwordResetTokenTTL),
+	}
+	if err := s.resetTokenRepo.Create(resetToken); err != nil {
+		return err
+	}
+
+	return s.mailer.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Restablecer contraseña",
+		Body: fmt.Sprintf("Hola %s,\n\nRecibimos una solicitud para restablecer tu contraseña. Usa este enlace antes de %d minutos:\n\n%s%s\n\nSi no la solicitaste, ignora este correo.\n",
+			user.Name, int(passwordResetTokenTTL.Minutes()), s.resetURL, token),
+	})
+}
+
+// ConfirmPasswordReset sets a new password with an emailed token and revokes every session of
+// the user.
+func (s *passwordResetService) ConfirmPasswordReset(req request.ConfirmPasswordResetRequest) error {
+	resetToken, err := s.resetTokenRepo.Consume(util.HashToken(req.Token), time.Now())
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetUserByID(resetToken.UserID)
+	if err != nil {
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	newPasswordHash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	user.Password = string(newPasswordHash)
+	if err := s.userRepo.UpdateUser(user); err != nil {
+		return fmt.Errorf("error updating password: %w", err)
+	}
+
+	return s.refreshTokenRepo.RevokeAllForUser(user.ID)
+}
@@ -8,9 +8,9 @@ import (
 )
 
 type ProductService interface {
-	GetAll() ([]response.ProductResponse, error)
+	GetAll(query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[response.ProductResponse], error)
 	GetByID(id uint) (*response.ProductResponse, error)
-	GetByEstablishmentID(establishmentID uint) ([]response.ProductResponse, error)
+	GetByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.ProductResponse], error)
 	Create(req request.CreateProductRequest) (*response.ProductResponse, error)
 	Update(id uint, req request.UpdateProductRequest) (*response.ProductResponse, error)
 	Delete(id uint) error
@@ -26,8 +26,8 @@ func NewProductService(productRepository repository.ProductRepository) ProductSe
 	}
 }
 
-func (s *productService) GetAll() ([]response.ProductResponse, error) {
-	products, err := s.productRepository.GetAll()
+func (s *productService) GetAll(query request.ListQuery, tenant repository.Tenant) (*response.PageResponse[response.ProductResponse], error) {
+	products, err := s.productRepository.GetAll(query, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +42,8 @@ func (s *productService) GetByID(id uint) (*response.ProductResponse, error) {
 	return product, nil
 }
 
-func (s *productService) GetByEstablishmentID(establishmentID uint) ([]response.ProductResponse, error) {
-	products, err := s.productRepository.GetByEstablishmentID(establishmentID)
+func (s *productService) GetByEstablishmentID(establishmentID uint, query request.ListQuery) (*response.PageResponse[response.ProductResponse], error) {
+	products, err := s.productRepository.GetByEstablishmentID(establishmentID, query)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+)
+
+// ReconciliationService defines the interface for balance reconciliation operations.
+type ReconciliationService interface {
+	Reconcile(establishmentID uint, dryRun bool) (*response.ReconciliationResponse, error)
+}
+
+type reconciliationService struct {
+	reconciliationRepo repository.ReconciliationRepository
+}
+
+// NewReconciliationService creates a new instance of ReconciliationService.
+func NewReconciliationService(reconciliationRepo repository.ReconciliationRepository) ReconciliationService {
+	return &reconciliationService{reconciliationRepo: reconciliationRepo}
+}
+
+// Reconcile checks the balance of every credit account of an establishment (all of them when
+// establishmentID is 0) against its history, transactions and ledger. Unless dryRun is set,
+// balances that disagree with the history are corrected with an ADJUSTMENT.
+func (s *reconciliationService) Reconcile(establishmentID uint, dryRun bool) (*response.ReconciliationResponse, error) {
+	creditAccountIDs, err := s.reconciliationRepo.GetCreditAccountIDs(establishmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &response.ReconciliationResponse{
+		EstablishmentID: establishmentID,
+		DryRun:          dryRun,
+		RunAt:           time.Now(),
+		Accounts:        []response.AccountReconciliationResponse{},
+	}
+	for _, creditAccountID := range creditAccountIDs {
+		account, err := s.reconciliationRepo.Reconcile(creditAccountID, !dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("error reconciling credit account %d: %w", creditAccountID, err)
+		}
+
+		report.AccountsChecked++
+		if account.Difference.IsZero() && account.TransactionDifference.IsZero() && account.LedgerDifference.IsZero() {
+			continue
+		}
+		report.Discrepancies++
+		if account.Adjusted {
+			report.Adjusted++
+		}
+		report.Accounts = append(report.Accounts, *account)
+	}
+
+	return report, nil
+}
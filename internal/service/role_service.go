@@ -0,0 +1,54 @@
+package service
+
+import (
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/repository"
+)
+
+// RoleService defines the interface for role service operations.
+type RoleService interface {
+	GetAllRoles() ([]response.RoleResponse, error)
+	AssignRoles(userID uint, req request.AssignRolesRequest) (*response.UserResponse, error)
+}
+
+type roleService struct {
+	roleRepo repository.RoleRepository
+}
+
+// NewRoleService creates a new instance of RoleService.
+func NewRoleService(roleRepo repository.RoleRepository) RoleService {
+	return &roleService{roleRepo: roleRepo}
+}
+
+// GetAllRoles retrieves the roles of the permission model.
+func (s *roleService) GetAllRoles() ([]response.RoleResponse, error) {
+	roles, err := s.roleRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]response.RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		responses = append(responses, response.RoleResponse{ID: role.ID, Name: role.Name})
+	}
+	return responses, nil
+}
+
+// AssignRoles replaces the roles of a user and the establishment it works at. The changes apply
+// from the user's next access token.
+func (s *roleService) AssignRoles(userID uint, req request.AssignRolesRequest) (*response.UserResponse, error) {
+	user, err := s.roleRepo.SetUserRoles(userID, req.Roles, req.EstablishmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.UserResponse{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Roles:     roleNames(user),
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}, nil
+}
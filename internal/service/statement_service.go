@@ -0,0 +1,124 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"ApiRestFinance/internal/export"
+	"ApiRestFinance/internal/finance"
+	"ApiRestFinance/internal/model/dto/request"
+	"ApiRestFinance/internal/model/dto/response"
+	"ApiRestFinance/internal/model/entities/enums"
+	"ApiRestFinance/internal/repository"
+	"gorm.io/gorm"
+)
+
+// StatementService defines the interface for statement service operations.
+type StatementService interface {
+	CloseCycle(creditAccountID uint) (*response.StatementResponse, error)
+	CloseCyclesForEstablishment(establishmentID uint) ([]response.StatementResponse, error)
+	GetStatementsByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.StatementResponse], error)
+	GetStatement(creditAccountID, statementID uint) (*response.StatementResponse, error)
+	ExportStatement(creditAccountID uint, from, to time.Time) (*export.AccountStatement, error)
+}
+
+type statementService struct {
+	statementRepo     repository.StatementRepository
+	creditAccountRepo repository.CreditAccountRepository
+}
+
+// NewStatementService creates a new instance of StatementService.
+func NewStatementService(statementRepo repository.StatementRepository, creditAccountRepo repository.CreditAccountRepository) StatementService {
+	return &statementService{
+		statementRepo:     statementRepo,
+		creditAccountRepo: creditAccountRepo,
+	}
+}
+
+// CloseCycle closes the latest billing cycle of a credit account.
+func (s *statementService) CloseCycle(creditAccountID uint) (*response.StatementResponse, error) {
+	return s.statementRepo.CloseCycle(creditAccountID, time.Now())
+}
+
+// CloseCyclesForEstablishment closes the latest billing cycle of every SHORT_TERM account of an
+// establishment. Accounts whose cycle is already closed are skipped.
+func (s *statementService) CloseCyclesForEstablishment(establishmentID uint) ([]response.StatementResponse, error) {
+	creditAccounts, err := s.creditAccountRepo.GetByEstablishmentID(establishmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credit accounts: %w", err)
+	}
+
+	// Use the same cut-off date for every account of the run
+	now := time.Now()
+	statements := []response.StatementResponse{}
+	for _, account := range creditAccounts {
+		if account.CreditType != enums.ShortTerm {
+			continue
+		}
+
+		statement, err := s.statementRepo.CloseCycle(account.ID, now)
+		if errors.Is(err, repository.ErrNoCycleToClose) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error closing cycle of account %d: %w", account.ID, err)
+		}
+		statements = append(statements, *statement)
+	}
+
+	return statements, nil
+}
+
+// GetStatementsByCreditAccountID retrieves a page of the statements of a credit account.
+func (s *statementService) GetStatementsByCreditAccountID(creditAccountID uint, query request.ListQuery) (*response.PageResponse[response.StatementResponse], error) {
+	return s.statementRepo.GetByCreditAccountID(creditAccountID, query)
+}
+
+// GetStatement retrieves a statement, making sure it belongs to the given credit account.
+func (s *statementService) GetStatement(creditAccountID, statementID uint) (*response.StatementResponse, error) {
+	statement, err := s.statementRepo.GetByID(statementID)
+	if err != nil {
+		return nil, err
+	}
+	if statement.CreditAccountID != creditAccountID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return statement, nil
+}
+
+// ExportStatement gathers the movements of a credit account between from and to (both inclusive)
+// so they can be rendered as a printable statement.
+func (s *statementService) ExportStatement(creditAccountID uint, from, to time.Time) (*export.AccountStatement, error) {
+	from, to = finance.DateOf(from), finance.DateOf(to)
+	if to.Before(from) {
+		return nil, errors.New("the end date must not be before the start date")
+	}
+
+	creditAccount, err := s.statementRepo.GetCreditAccount(creditAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, err := s.statementRepo.GetBalanceBefore(creditAccountID, from)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving opening balance: %w", err)
+	}
+
+	history, err := s.statementRepo.GetHistory(creditAccountID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credit account history: %w", err)
+	}
+
+	return &export.AccountStatement{
+		Establishment: creditAccount.Establishment,
+		Client:        creditAccount.Client,
+		CreditAccount: *creditAccount,
+		From:          from,
+		To:            to,
+		GeneratedAt:   time.Now(),
+		Summary:       finance.BuildStatement(creditAccountID, from, to, to, opening, history),
+		Movements:     history,
+	}, nil
+}
@@ -13,9 +13,12 @@ import (
 type TransactionService interface {
 	CreateTransaction(req request.CreateTransactionRequest) (*response.TransactionResponse, error)
 	GetTransactionByID(id uint) (*response.TransactionResponse, error)
-	UpdateTransaction(id uint, req request.UpdateTransactionRequest) (*response.TransactionResponse, error)
-	DeleteTransaction(id uint) error
-	GetTransactionsByCreditAccountID(creditAccountID uint) ([]response.TransactionResponse, error)
+	ReverseTransaction(id uint, req request.ReverseTransactionRequest) (*response.TransactionResponse, error)
+	CreateAdjustment(creditAccountID uint, req request.CreateAdjustmentRequest) (*response.TransactionResponse, error)
+	WriteOff(creditAccountID uint, req request.WriteOffRequest) (*response.TransactionResponse, error)
+	GetTransactionsByCreditAccountID(creditAccountID uint, query request.TransactionSearchQuery) (*response.PageResponse[response.TransactionResponse], error)
+	SearchTransactionHistory(creditAccountID uint, query request.TransactionSearchQuery) (*response.TransactionHistoryResponse, error)
+	GetPaymentAllocations(transactionID uint) ([]response.PaymentAllocationResponse, error)
 }
 
 type transactionService struct {
@@ -31,37 +34,18 @@ func NewTransactionService(transactionRepo repository.TransactionRepository, cre
 	}
 }
 
-// CreateTransaction creates a new transaction, updating the credit account balance.
+// CreateTransaction posts a purchase, charge or payment on a credit account, updating its balance.
 func (s *transactionService) CreateTransaction(req request.CreateTransactionRequest) (*response.TransactionResponse, error) {
-	// Retrieve the credit account to update the balance
-	creditAccount, err := s.creditAccountRepo.GetByID(req.CreditAccountID)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving credit account: %w", err)
-	}
-
-	// Create the transaction record
-	transaction, err := s.transactionRepo.Create(req)
-	if err != nil {
-		return nil, fmt.Errorf("error creating transaction: %w", err)
-	}
-
-	// Update the credit account balance based on transaction type
 	switch req.TransactionType {
-	case enums.Purchase, enums.InterestAccrual, enums.LateFeeApplied: // Use enums.TransactionType
-		creditAccount.CurrentBalance += req.Amount
-	case enums.Payment, enums.EarlyPayment: // Use enums.TransactionType
-		creditAccount.CurrentBalance -= req.Amount
-	// Add other transaction types as needed
+	case enums.Purchase, enums.InterestAccrual, enums.LateFeeApplied, enums.Payment, enums.EarlyPayment:
+	// Reversals, adjustments and write-offs have their own endpoints
 	default:
 		return nil, fmt.Errorf("invalid transaction type: %s", req.TransactionType)
 	}
 
-	// Update the credit account in the database
-	_, err = s.creditAccountRepo.Update(creditAccount.ID, request.UpdateCreditAccountRequest{
-		CurrentBalance: creditAccount.CurrentBalance, // Assign to correct field
-	})
+	transaction, err := s.transactionRepo.Create(req)
 	if err != nil {
-		return nil, fmt.Errorf("error updating credit account balance: %w", err)
+		return nil, fmt.Errorf("error creating transaction: %w", err)
 	}
 
 	return transaction, nil
@@ -72,99 +56,48 @@ func (s *transactionService) GetTransactionByID(id uint) (*response.TransactionR
 	return s.transactionRepo.GetByID(id)
 }
 
-// UpdateTransaction updates an existing transaction and adjusts the credit account balance.
-func (s *transactionService) UpdateTransaction(id uint, req request.UpdateTransactionRequest) (*response.TransactionResponse, error) {
-	// Retrieve the original transaction
-	originalTransaction, err := s.transactionRepo.GetByID(id)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving transaction: %w", err)
-	}
-
-	// Retrieve the credit account to update the balance
-	creditAccount, err := s.creditAccountRepo.GetByID(originalTransaction.CreditAccountID)
+// ReverseTransaction cancels a posted transaction with a REVERSAL that references it.
+func (s *transactionService) ReverseTransaction(id uint, req request.ReverseTransactionRequest) (*response.TransactionResponse, error) {
+	reversal, err := s.transactionRepo.Reverse(id, req)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving credit account: %w", err)
-	}
-
-	// Adjust the credit account balance based on the original and new transaction amounts
-	switch originalTransaction.TransactionType {
-	case enums.Purchase, enums.InterestAccrual, enums.LateFeeApplied:
-		creditAccount.CurrentBalance -= originalTransaction.Amount
-	case enums.Payment, enums.EarlyPayment:
-		creditAccount.CurrentBalance += originalTransaction.Amount
-		// Add other transaction types as needed
-	}
-
-	if req.Amount > 0 { // Only adjust if a new amount is provided
-		switch req.TransactionType {
-		case enums.Purchase, enums.InterestAccrual, enums.LateFeeApplied:
-			creditAccount.CurrentBalance += req.Amount
-		case enums.Payment, enums.EarlyPayment:
-			creditAccount.CurrentBalance -= req.Amount
-			// Add other transaction types as needed
-		}
+		return nil, fmt.Errorf("error reversing transaction: %w", err)
 	}
 
-	// Update the transaction
-	updatedTransaction, err := s.transactionRepo.Update(id, req)
-	if err != nil {
-		return nil, fmt.Errorf("error updating transaction: %w", err)
-	}
+	return reversal, nil
+}
 
-	// Update the credit account balance in the database
-	_, err = s.creditAccountRepo.Update(creditAccount.ID, request.UpdateCreditAccountRequest{
-		CurrentBalance: creditAccount.CurrentBalance,
-	})
+// CreateAdjustment posts a manual correction of the balance of a credit account.
+func (s *transactionService) CreateAdjustment(creditAccountID uint, req request.CreateAdjustmentRequest) (*response.TransactionResponse, error) {
+	adjustment, err := s.transactionRepo.Adjust(creditAccountID, req)
 	if err != nil {
-		return nil, fmt.Errorf("error updating credit account balance: %w", err)
+		return nil, fmt.Errorf("error creating adjustment: %w", err)
 	}
 
-	return updatedTransaction, nil
+	return adjustment, nil
 }
 
-// DeleteTransaction deletes a transaction and adjusts the credit account balance.
-func (s *transactionService) DeleteTransaction(id uint) error {
-	// Retrieve the transaction to be deleted
-	transaction, err := s.transactionRepo.GetByID(id)
+// WriteOff charges the outstanding balance of a credit account to bad debt.
+func (s *transactionService) WriteOff(creditAccountID uint, req request.WriteOffRequest) (*response.TransactionResponse, error) {
+	writeOff, err := s.transactionRepo.WriteOff(creditAccountID, req)
 	if err != nil {
-		return fmt.Errorf("error retrieving transaction: %w", err)
-	}
-
-	// Retrieve the credit account to update the balance
-	creditAccount, err := s.creditAccountRepo.GetByID(transaction.CreditAccountID)
-	if err != nil {
-		return fmt.Errorf("error retrieving credit account: %w", err)
-	}
-
-	// Adjust the credit account balance based on the deleted transaction
-	switch transaction.TransactionType {
-	case enums.Purchase, enums.InterestAccrual, enums.LateFeeApplied:
-		creditAccount.CurrentBalance -= transaction.Amount
-	case enums.Payment, enums.EarlyPayment:
-		creditAccount.CurrentBalance += transaction.Amount
-	// Add other transaction types as needed
-	default:
-		return fmt.Errorf("invalid transaction type: %s", transaction.TransactionType)
+		return nil, fmt.Errorf("error writing off credit account: %w", err)
 	}
 
-	// Delete the transaction
-	err = s.transactionRepo.Delete(id)
-	if err != nil {
-		return fmt.Errorf("error deleting transaction: %w", err)
-	}
+	return writeOff, nil
+}
 
-	// Update the credit account balance in the database
-	_, err = s.creditAccountRepo.Update(creditAccount.ID, request.UpdateCreditAccountRequest{
-		CurrentBalance: creditAccount.CurrentBalance,
-	})
-	if err != nil {
-		return fmt.Errorf("error updating credit account balance: %w", err)
-	}
+// GetTransactionsByCreditAccountID retrieves a page of the transactions of a credit account.
+func (s *transactionService) GetTransactionsByCreditAccountID(creditAccountID uint, query request.TransactionSearchQuery) (*response.PageResponse[response.TransactionResponse], error) {
+	return s.transactionRepo.GetByCreditAccountID(creditAccountID, query)
+}
 
-	return nil
+// SearchTransactionHistory retrieves a page of the history of a credit account, with running
+// balances and the totals per transaction type of the period searched.
+func (s *transactionService) SearchTransactionHistory(creditAccountID uint, query request.TransactionSearchQuery) (*response.TransactionHistoryResponse, error) {
+	return s.transactionRepo.SearchHistory(creditAccountID, query)
 }
 
-// GetTransactionsByCreditAccountID retrieves transactions for a specific credit account.
-func (s *transactionService) GetTransactionsByCreditAccountID(creditAccountID uint) ([]response.TransactionResponse, error) {
-	return s.transactionRepo.GetByCreditAccountID(creditAccountID)
+// GetPaymentAllocations retrieves how a payment was allocated.
+func (s *transactionService) GetPaymentAllocations(transactionID uint) ([]response.PaymentAllocationResponse, error) {
+	return s.transactionRepo.GetAllocations(transactionID)
 }
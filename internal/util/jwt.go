@@ -1,29 +1,60 @@
 package util
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
+	"ApiRestFinance/internal/auth"
+	"ApiRestFinance/internal/model/entities/enums"
 	"github.com/golang-jwt/jwt/v4"
 )
 
+// Token types, carried in the token_type claim so one kind of token is never accepted as the other
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+)
+
+// RefreshTokenTTL is how long a refresh token stays valid.
+const RefreshTokenTTL = 7 * 24 * time.Hour
+
+// ErrWrongTokenType is returned when a token of another type is presented.
+var ErrWrongTokenType = errors.New("wrong token type")
+
 // AccessTokenClaims for access tokens
 type AccessTokenClaims struct {
-	UserID uint `json:"user_id"`
+	TokenType       string   `json:"token_type"`
+	UserID          uint     `json:"user_id"`
+	Roles           []string `json:"roles"`                      // Names of the roles of the user when the token was issued
+	EstablishmentID uint     `json:"establishment_id,omitempty"` // Establishment the user works at, 0 for clients and platform admins
+	ClientID        uint     `json:"client_id,omitempty"`        // Client record of the user, 0 when it is not a client
 	jwt.RegisteredClaims
 }
 
-// RefreshTokenClaims for refresh tokens
+// RefreshTokenClaims for refresh tokens, the registered ID identifies the persisted token
 type RefreshTokenClaims struct {
-	UserID uint `json:"user_id"`
+	TokenType string `json:"token_type"`
+	UserID    uint   `json:"user_id"`
 	jwt.RegisteredClaims
 }
 
-// GenerateAccessToken generates a new JWT access token
-func GenerateAccessToken(userID uint, jwtSecret string) (string, error) {
+// GenerateAccessToken generates a new JWT access token for a principal
+func GenerateAccessToken(principal auth.Principal, jwtSecret string) (string, error) {
 	expirationTime := time.Now().Add(15 * time.Minute) // 15 minutes expiration
+	roles := make([]string, 0, len(principal.Roles))
+	for _, role := range principal.Roles {
+		roles = append(roles, string(role))
+	}
 	claims := &AccessTokenClaims{
-		UserID: userID,
+		TokenType:       AccessTokenType,
+		UserID:          principal.UserID,
+		Roles:           roles,
+		EstablishmentID: principal.EstablishmentID,
+		ClientID:        principal.ClientID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -34,15 +65,15 @@ func GenerateAccessToken(userID uint, jwtSecret string) (string, error) {
 	return token.SignedString([]byte(jwtSecret))
 }
 
-// GenerateRefreshToken generates a new JWT refresh token
-func GenerateRefreshToken(userID uint, jwtSecret string) (string, error) {
-	expirationTime := time.Now().Add(7 * 24 * time.Hour) // 7 days expiration
+// GenerateRefreshToken generates a new JWT refresh token with the given ID and expiration
+func GenerateRefreshToken(userID uint, tokenID string, expiresAt time.Time, jwtSecret string) (string, error) {
 	claims := &RefreshTokenClaims{
-		UserID: userID,
+		TokenType: RefreshTokenType,
+		UserID:    userID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			// You can add other registered claims if needed
 		},
 	}
 	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -59,3 +90,74 @@ func ValidateToken(tokenString string, jwtSecret string) (*jwt.Token, error) {
 		return []byte(jwtSecret), nil
 	})
 }
+
+// ParseAccessToken validates an access token and returns its claims. Refresh tokens are rejected.
+func ParseAccessToken(tokenString string, jwtSecret string) (*AccessTokenClaims, error) {
+	claims := &AccessTokenClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("invalid signing method: %v", token.Header["alg"])
+		}
+		return []byte(jwtSecret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("invalid access token")
+	}
+	if claims.TokenType != AccessTokenType {
+		return nil, ErrWrongTokenType
+	}
+	return claims, nil
+}
+
+// Principal returns the caller identified by the claims of an access token.
+func (c *AccessTokenClaims) Principal() auth.Principal {
+	roles := make([]enums.RoleName, 0, len(c.Roles))
+	for _, role := range c.Roles {
+		roles = append(roles, enums.RoleName(role))
+	}
+	return auth.Principal{
+		UserID:          c.UserID,
+		Roles:           roles,
+		EstablishmentID: c.EstablishmentID,
+		ClientID:        c.ClientID,
+	}
+}
+
+// ParseRefreshToken validates a refresh token and returns its claims. Access tokens are rejected.
+func ParseRefreshToken(tokenString string, jwtSecret string) (*RefreshTokenClaims, error) {
+	claims := &RefreshTokenClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("invalid signing method: %v", token.Header["alg"])
+		}
+		return []byte(jwtSecret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid || claims.ID == "" {
+		return nil, errors.New("invalid refresh token")
+	}
+	if claims.TokenType != RefreshTokenType {
+		return nil, ErrWrongTokenType
+	}
+	return claims, nil
+}
+
+// NewTokenID returns a random identifier for a token or a token family.
+func NewTokenID() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating token ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashToken returns the SHA-256 of a token or token ID, as stored in the database.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}